@@ -1,6 +1,15 @@
 package promises
 
-import "sync"
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+)
 
 // All takes an array of promises and returns a single promise. This returned
 // promise fulfills when all of the input's promises fulfill (including when an
@@ -8,26 +17,303 @@ import "sync"
 // rejects when any of the input's promises rejects, with this first rejection
 // reason.
 func All[T any](ps ...Promise[T]) Promise[[]T] {
+	return AllCtx(context.Background(), ps...)
+}
+
+// AllCtx acts like [All], but also rejects with ctx.Err() if the context is
+// done before the returned promise is settled. With no input promises, it
+// rejects with ctx.Err() if the context is already done, and fulfills
+// immediately otherwise.
+func AllCtx[T any](ctx context.Context, ps ...Promise[T]) Promise[[]T] {
+	return all(ctx, nil, ps)
+}
+
+// AllProgress acts like [All], but calls onProgress after each of the input's
+// promises settles, with the number of settled promises and the total number of
+// promises. The onProgress is called from the aggregating goroutine in the
+// order of settlement, so it must be fast and must not block.
+func AllProgress[T any](onProgress func(done, total int), ps ...Promise[T]) Promise[[]T] {
+	return all(context.Background(), onProgress, ps)
+}
+
+func all[T any](ctx context.Context, onProgress func(done, total int), ps []Promise[T]) Promise[[]T] {
 	if len(ps) == 0 {
+		if err := ctx.Err(); err != nil {
+			return Reject[[]T](err)
+		}
 		return Resolve[[]T](nil)
 	}
 	return New(func() ([]T, error) {
-		agg, abort := collectResults(ps)
+		agg, abort := collectResultsCtx(ctx, ps)
 		defer close(abort)
 
 		values := make([]T, len(ps))
 		settled := 0
 		for r := range agg {
 			settled++
+			if onProgress != nil {
+				onProgress(settled, len(ps))
+			}
 			if r.Err != nil {
 				return nil, r.Err
 			}
 			values[r.Index] = r.Value
 			if settled == len(ps) {
+				return values, nil
+			}
+		}
+
+		return nil, ctx.Err()
+	})
+}
+
+// Min waits for all of the given promises (see [All]) and fulfills with the
+// smallest fulfillment value (see [slices.Min]). It rejects with the first
+// rejection reason, or with [ErrNoPromises] if no promises are given.
+func Min[T cmp.Ordered](ps ...Promise[T]) Promise[T] {
+	return reduceAll(slices.Min[[]T], ps)
+}
+
+// Max acts like [Min], but fulfills with the largest fulfillment value.
+func Max[T cmp.Ordered](ps ...Promise[T]) Promise[T] {
+	return reduceAll(slices.Max[[]T], ps)
+}
+
+func reduceAll[T any](reduce func([]T) T, ps []Promise[T]) Promise[T] {
+	if len(ps) == 0 {
+		return Reject[T](ErrNoPromises)
+	}
+	return ThenMap(All(ps...), reduce)
+}
+
+// AllInOrderOfCompletion acts like [All], but the returned promise fulfills with
+// the array of the fulfillment values in the order in which the input's
+// promises fulfilled, not in the order of the input.
+func AllInOrderOfCompletion[T any](ps ...Promise[T]) Promise[[]T] {
+	if len(ps) == 0 {
+		return Resolve[[]T](nil)
+	}
+	return New(func() ([]T, error) {
+		agg, abort := collectResults(ps)
+		defer close(abort)
+
+		values := make([]T, 0, len(ps))
+		for r := range agg {
+			if r.Err != nil {
+				return nil, r.Err
+			}
+			values = append(values, r.Value)
+		}
+
+		return values, nil
+	})
+}
+
+// AllStream sends the outcomes of the given promises to the returned channel in
+// the order of settlement, and closes the channel when all of them are sent.
+// The channel has the given buffer size, so at most bufferSize outcomes are
+// kept if the consumer is slow; the rest of them wait for the consumer. The
+// returned abort function stops the stream: the outcomes that are not sent yet
+// are dropped, and the channel is closed (the buffered outcomes are still
+// delivered). The abort function is idempotent and safe to call from any
+// goroutine. The consumer must either read the channel to the end or call
+// abort, otherwise the internal goroutines leak.
+func AllStream[T any](bufferSize int, ps ...Promise[T]) (<-chan Result[T], func()) {
+	agg, abortChan := collectResults(ps)
+	out := make(chan Result[T], max(bufferSize, 0))
+	stop := make(chan struct{})
+	var once sync.Once
+	abort := func() {
+		once.Do(func() {
+			close(stop)
+			close(abortChan)
+		})
+	}
+
+	go func() {
+		defer close(out)
+		for r := range agg {
+			select {
+			case <-stop:
+				continue
+			default:
+			}
+			select {
+			case out <- r.Result:
+			case <-stop:
+			}
+		}
+	}()
+	return out, abort
+}
+
+// AllUntil acts like [AllInOrderOfCompletion], but calls stop with the values
+// collected so far after each fulfillment. Once stop returns true, the
+// returned promise fulfills with the collected values, and the rest of the
+// promises are no longer waited for. If any of the promises rejects before
+// that, the returned promise rejects with this first rejection reason.
+func AllUntil[T any](stop func(collected []T) bool, ps ...Promise[T]) Promise[[]T] {
+	if len(ps) == 0 {
+		return Resolve[[]T](nil)
+	}
+	return New(func() ([]T, error) {
+		agg, abort := collectResults(ps)
+		defer close(abort)
+
+		values := make([]T, 0, len(ps))
+		for r := range agg {
+			if r.Err != nil {
+				return nil, r.Err
+			}
+			values = append(values, r.Value)
+			if stop(values) {
 				break
 			}
 		}
+		return values, nil
+	})
+}
 
+// Combine waits for all of the as and bs promises (see [All]) and, if all of
+// them fulfilled, calls fn for every pair of their values: for each value of
+// as, for each value of bs (the row-major order). The returned promise fulfills
+// with the array of len(as)*len(bs) results. It rejects with the first
+// rejection reason of the input's promises, or with the first fn error; fn is
+// not called for the remaining pairs then.
+func Combine[A, B, R any](as []Promise[A], bs []Promise[B], fn func(A, B) (R, error)) Promise[[]R] {
+	allAs, allBs := untracked(All(as...)), untracked(All(bs...))
+	return New(func() ([]R, error) {
+		vas, err := allAs.Wait()
+		if err != nil {
+			return nil, err
+		}
+		vbs, err := allBs.Wait()
+		if err != nil {
+			return nil, err
+		}
+		results := make([]R, 0, len(vas)*len(vbs))
+		for _, a := range vas {
+			for _, b := range vbs {
+				r, err := fn(a, b)
+				if err != nil {
+					return nil, err
+				}
+				results = append(results, r)
+			}
+		}
+		return results, nil
+	})
+}
+
+// AllWithItemTimeout acts like [All], but wraps each of the input's promises
+// with [WithTimeout], so each of them must settle within d. Otherwise, the
+// returned promise rejects with a [TimeoutError], that matches both
+// [ErrTimeout] and [context.DeadlineExceeded].
+func AllWithItemTimeout[T any](d time.Duration, ps ...Promise[T]) Promise[[]T] {
+	wrapped := make([]Promise[T], len(ps))
+	for i, p := range ps {
+		wrapped[i] = untracked(WithTimeout(d, p))
+	}
+	return All(wrapped...)
+}
+
+// ScatterGather is the scatter-gather counterpart of [AllWithItemTimeout]: it
+// wraps each of the input's promises with [WithTimeout] and waits for all of
+// them to settle (see [AllSettledCtx]). The returned promise never rejects; the
+// entries of the promises that didn't settle within perShardTimeout carry the
+// [TimeoutError], that matches [context.DeadlineExceeded]. So the slow shards
+// are tolerated, and the total wait is bounded by perShardTimeout.
+func ScatterGather[T any](perShardTimeout time.Duration, ps ...Promise[T]) Promise[Results[T]] {
+	wrapped := make([]Promise[T], len(ps))
+	for i, p := range ps {
+		wrapped[i] = untracked(WithTimeout(perShardTimeout, p))
+	}
+	return AllSettledCtx(context.Background(), wrapped...)
+}
+
+// AllErrors takes an array of promises and returns a single promise. Unlike
+// [All], it doesn't fail fast, but waits for all of the input's promises to
+// settle. The returned promise fulfills when all of the input's promises
+// fulfill, with an array of the fulfillment values. If some of them reject, the
+// returned promise rejects with an [AggregateError] containing an array of
+// rejection reasons (nil for the fulfilled promises).
+//
+// In case of rejection, the Wait method of the returned promise still returns
+// the array of values (with zero values for the rejected promises) along with
+// the error, so the partial results are not lost.
+func AllErrors[T any](ps ...Promise[T]) Promise[[]T] {
+	if len(ps) == 0 {
+		return Resolve[[]T](nil)
+	}
+
+	p := newImpl[[]T]()
+	go func() {
+		defer handlePanic(p.reject)
+		results, _ := AllSettled(ps...).Wait()
+
+		values := make([]T, len(ps))
+		errs := NewErrors(len(ps))
+		for i, r := range results {
+			values[i] = r.Value
+			errs.Set(i, r.Err)
+		}
+
+		if errs.HasAny() {
+			p.settle(values, &AggregateError{errs})
+		} else {
+			p.resolve(values)
+		}
+	}()
+	return p
+}
+
+// AllWithFailures waits for all of the given promises to settle and returns the
+// array of their fulfillment values (zero values for the rejected promises) and
+// the map from the indices of the rejected promises to their rejection reasons.
+// Unlike the other aggregates, it blocks and returns the results directly.
+func AllWithFailures[T any](ps ...Promise[T]) (values []T, failures map[int]error) {
+	values = make([]T, len(ps))
+	failures = make(map[int]error)
+	for i, p := range ps {
+		v, err := p.Wait()
+		if err != nil {
+			failures[i] = err
+		} else {
+			values[i] = v
+		}
+	}
+	return values, failures
+}
+
+// AllTolerant acts like [All], but tolerates up to maxFailures rejections: the
+// returned promise fulfills with the array of the fulfillment values (zero
+// values for the rejected promises) if no more than maxFailures of the input's
+// promises reject. Once the number of rejections exceeds maxFailures, it
+// rejects immediately with an [AggregateError] of the rejections seen so far
+// (nil entries for the fulfilled and still pending promises), and the rest of
+// the promises are no longer waited for.
+func AllTolerant[T any](maxFailures int, ps ...Promise[T]) Promise[[]T] {
+	if len(ps) == 0 {
+		return Resolve[[]T](nil)
+	}
+	return New(func() ([]T, error) {
+		agg, abort := collectResults(ps)
+		defer close(abort)
+
+		values := make([]T, len(ps))
+		errs := NewErrors(len(ps))
+		failures := 0
+		for r := range agg {
+			if r.Err != nil {
+				errs.Set(r.Index, r.Err)
+				failures++
+				if failures > maxFailures {
+					return nil, &AggregateError{errs}
+				}
+				continue
+			}
+			values[r.Index] = r.Value
+		}
 		return values, nil
 	})
 }
@@ -38,24 +324,124 @@ func All[T any](ps ...Promise[T]) Promise[[]T] {
 // (including when an empty iterable is passed), with an [AggregateError]
 // containing an array of rejection reasons.
 func Any[T any](ps ...Promise[T]) Promise[T] {
+	return AnyCtx(context.Background(), ps...)
+}
+
+// AnyCtx acts like [Any], but also rejects with ctx.Err() if the context is
+// done before the returned promise is settled. With no input promises, it
+// rejects immediately: with ctx.Err() if the context is already done, and with
+// the empty [AggregateError] otherwise.
+func AnyCtx[T any](ctx context.Context, ps ...Promise[T]) Promise[T] {
 	if len(ps) == 0 {
+		if err := ctx.Err(); err != nil {
+			return Reject[T](err)
+		}
 		return Reject[T](new(AggregateError))
 	}
 
 	return New(func() (T, error) {
-		agg, abort := collectResults(ps)
+		agg, abort := collectResultsCtx(ctx, ps)
 		defer close(abort)
 
-		errs := make([]error, len(ps))
+		errs := NewErrors(len(ps))
 		settled := 0
 		for r := range agg {
 			settled++
 			if r.Err == nil {
 				return r.Value, nil
 			}
-			errs[r.Index] = r.Err
+			errs.Set(r.Index, r.Err)
 			if settled == len(ps) {
-				break
+				return zero[T](), &AggregateError{errs}
+			}
+		}
+
+		return zero[T](), ctx.Err()
+	})
+}
+
+// FirstSuccess runs all the functions concurrently (see [New]) and returns a
+// promise that fulfills with the first successful result, or rejects with an
+// [AggregateError] of all errors if all functions fail. It is [Any] built from
+// functions. Once one of the functions succeeds, the others are no longer
+// waited for, but they can not be stopped and keep running in background.
+func FirstSuccess[T any](fns ...func() (T, error)) Promise[T] {
+	ps := make([]Promise[T], len(fns))
+	for i, fn := range fns {
+		ps[i] = untracked(New(fn))
+	}
+	return Any(ps...)
+}
+
+// FirstNonPanic runs all the functions concurrently and returns a promise that
+// fulfills with the first value returned by a function that didn't panic. If
+// all functions panic, it rejects with an [AggregateError] of [ErrPanic]
+// errors. It is a resilience primitive for running plugins or other untrusted
+// code; see [FirstSuccess].
+func FirstNonPanic[T any](fns ...func() T) Promise[T] {
+	gens := make([]func() (T, error), len(fns))
+	for i, fn := range fns {
+		fn := fn
+		gens[i] = func() (T, error) { return fn(), nil }
+	}
+	return FirstSuccess(gens...)
+}
+
+// FirstWhere takes an array of promises and returns a single promise. This
+// returned promise fulfills with the first fulfillment value that satisfies the
+// pred. The fulfillment values that don't satisfy the pred are ignored, as well
+// as the rejections. If none of the input's promises fulfills with the
+// satisfying value, the returned promise rejects with an [AggregateError]
+// containing the rejection reasons, or [ErrNoMatch] for the fulfilled promises.
+func FirstWhere[T any](pred func(T) bool, ps ...Promise[T]) Promise[T] {
+	if len(ps) == 0 {
+		return Reject[T](new(AggregateError))
+	}
+
+	return New(func() (T, error) {
+		agg, abort := collectResults(ps)
+		defer close(abort)
+
+		errs := make([]error, len(ps))
+		for r := range agg {
+			if r.Err != nil {
+				errs[r.Index] = r.Err
+			} else if pred(r.Value) {
+				return r.Value, nil
+			} else {
+				errs[r.Index] = ErrNoMatch
+			}
+		}
+
+		return zero[T](), &AggregateError{errs}
+	})
+}
+
+// AnyDistinct acts like [Any], but skips the fulfillment values that are
+// already present in the seen set (the keys of the map). The first value that
+// is not in the set is added to it, and the returned promise fulfills with
+// this value. If there is no such value, the returned promise rejects with an
+// [AggregateError] containing the rejection reasons, or [ErrDuplicate] for the
+// fulfilled promises. The seen map can be shared by many concurrent AnyDistinct
+// calls: the check-and-insert is atomic (see [sync.Map.LoadOrStore]), so each
+// value wins at most once across all of them.
+func AnyDistinct[T comparable](seen *sync.Map, ps ...Promise[T]) Promise[T] {
+	if len(ps) == 0 {
+		return Reject[T](new(AggregateError))
+	}
+
+	return New(func() (T, error) {
+		agg, abort := collectResults(ps)
+		defer close(abort)
+
+		errs := make([]error, len(ps))
+		for r := range agg {
+			if r.Err != nil {
+				errs[r.Index] = r.Err
+			} else if _, loaded := seen.LoadOrStore(r.Value, struct{}{}); !loaded {
+				return r.Value, nil
+			} else {
+				errs[r.Index] = ErrDuplicate
 			}
 		}
 
@@ -66,21 +452,190 @@ func Any[T any](ps ...Promise[T]) Promise[T] {
 // Race takes an array of promises and returns a single Promise. This returned
 // promise settles with the eventual state of the first promise that settles.
 func Race[T any](ps ...Promise[T]) Promise[T] {
+	return RaceCtx(context.Background(), ps...)
+}
+
+// RaceCtx acts like [Race], but also rejects with ctx.Err() if the context is
+// done before the returned promise is settled. With no input promises, it
+// settles only when the context is done (see [Ctx]), so it never settles if
+// the context can not be done.
+func RaceCtx[T any](ctx context.Context, ps ...Promise[T]) Promise[T] {
 	if len(ps) == 0 {
-		p, _, _ := WithResolvers[T]()
-		return p
+		if ctx.Done() == nil {
+			p, _, _ := WithResolvers[T]()
+			return p
+		}
+		return Ctx[T](ctx)
 	}
 
 	return New(func() (T, error) {
-		agg, abort := collectResults(ps)
+		agg, abort := collectResultsCtx(ctx, ps)
 		defer close(abort)
 
 		for r := range agg {
 			return r.Value, r.Err
 		}
 
-		// We should never reach this
-		return zero[T](), nil
+		return zero[T](), ctx.Err()
+	})
+}
+
+// WaitAny blocks until the first of the given promises settles, and returns
+// its index and outcome. Unlike [Race], it doesn't create a new promise or
+// goroutines: it waits on the Done channels of all promises in a single
+// select. If no promises are given, it returns -1 immediately.
+func WaitAny[T any](ps ...Promise[T]) (index int, value T, err error) {
+	if len(ps) == 0 {
+		return -1, zero[T](), nil
+	}
+	cases := make([]reflect.SelectCase, len(ps))
+	for i, p := range ps {
+		cases[i] = reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(p.Done()),
+		}
+	}
+	index, _, _ = reflect.Select(cases)
+	value, err = ps[index].Wait()
+	return index, value, err
+}
+
+// Preferred takes an array of promises and returns a single promise. Like
+// [Any], this returned promise fulfills with a fulfillment value of one of the
+// input's promises, but it prefers the earlier promises: it fulfills with the
+// value of the lowest-indexed promise that fulfills, so it waits until all
+// promises before it reject. It rejects when all of the input's promises
+// reject, with an [AggregateError] containing an array of rejection reasons.
+func Preferred[T any](ps ...Promise[T]) Promise[T] {
+	if len(ps) == 0 {
+		return Reject[T](new(AggregateError))
+	}
+
+	return New(func() (T, error) {
+		agg, abort := collectResults(ps)
+		defer close(abort)
+
+		values := make([]T, len(ps))
+		settled := make([]bool, len(ps))
+		errs := make([]error, len(ps))
+		for r := range agg {
+			values[r.Index], errs[r.Index] = r.Value, r.Err
+			settled[r.Index] = true
+			for i := range ps {
+				if !settled[i] {
+					// Not settled yet, it can still fulfill
+					break
+				}
+				if errs[i] == nil {
+					return values[i], nil
+				}
+			}
+		}
+
+		return zero[T](), &AggregateError{errs}
+	})
+}
+
+// RaceResult acts like [Race], but the returned promise never rejects: it
+// fulfills with the [Result] describing the outcome of the first settled
+// promise. It allows to distinguish the zero fulfillment value from the
+// rejection.
+func RaceResult[T any](ps ...Promise[T]) Promise[Result[T]] {
+	return Reflect(Race(ps...))
+}
+
+// RaceWithLosers acts like [Race], but also returns a channel that receives the
+// results of the losing promises as they settle, so that the resources they
+// allocated can be cleaned up. The channel is buffered, so the results are not
+// lost if nobody reads it, and it is closed once all losers settle.
+func RaceWithLosers[T any](ps ...Promise[T]) (Promise[T], <-chan Result[T]) {
+	winner, resolve, reject := WithResolvers[T]()
+	losers := make(chan Result[T], max(len(ps)-1, 0))
+	if len(ps) == 0 {
+		close(losers)
+		return winner, losers
+	}
+
+	go func() {
+		defer close(losers)
+		agg, abort := collectResults(ps)
+		defer close(abort)
+
+		first := true
+		for r := range agg {
+			if !first {
+				losers <- r.Result
+				continue
+			}
+			first = false
+			if r.Err != nil {
+				reject(r.Err)
+			} else {
+				resolve(r.Value)
+			}
+		}
+	}()
+	return winner, losers
+}
+
+// WaitCount takes an array of promises and returns a single promise. This
+// returned promise fulfills when at least n of the input's promises settle,
+// regardless of whether they fulfill or reject. It rejects immediately if n is
+// greater than the number of promises.
+func WaitCount[T any](n int, ps ...Promise[T]) Promise[struct{}] {
+	if n > len(ps) {
+		return Reject[struct{}](fmt.Errorf(
+			"cannot wait for %d of %d promises", n, len(ps),
+		))
+	}
+	if n <= 0 {
+		return Resolve(struct{}{})
+	}
+
+	return NewVoid(func() error {
+		agg, abort := collectResults(ps)
+		defer close(abort)
+
+		settled := 0
+		for range agg {
+			settled++
+			if settled == n {
+				break
+			}
+		}
+		return nil
+	})
+}
+
+// RaceReport describes the outcome of a race, see [RaceOutcome].
+type RaceReport[T any] struct {
+	// Index is the index of the first settled promise.
+	Index int
+	// Result is the outcome of the first settled promise.
+	Result Result[T]
+	// Others fulfills when all of the raced promises settle, with their
+	// outcomes in the order of the input (including the winner).
+	Others Promise[Results[T]]
+}
+
+// RaceOutcome acts like [Race], but the returned promise never rejects: it
+// fulfills as soon as the first of the input's promises settles, with the
+// [RaceReport] containing the winner index and outcome. The outcomes of the
+// losers are available later through the Others promise of the report. It
+// gives the full visibility into the race, e.g. for debugging slow backends.
+func RaceOutcome[T any](ps ...Promise[T]) Promise[RaceReport[T]] {
+	if len(ps) == 0 {
+		p, _, _ := WithResolvers[RaceReport[T]]()
+		return p
+	}
+
+	others := AllSettledCtx(context.Background(), ps...)
+	return New(func() (RaceReport[T], error) {
+		agg, abort := collectResults(ps)
+		defer close(abort)
+
+		r := <-agg
+		return RaceReport[T]{r.Index, r.Result, others}, nil
 	})
 }
 
@@ -94,15 +649,77 @@ func AllSettled[T any](ps ...Promise[T]) Promise[[]Result[T]] {
 	}
 
 	return New(func() ([]Result[T], error) {
+		return settleAll(context.Background(), ps), nil
+	})
+}
+
+// AllSettledCtx acts like [AllSettled], but if the context is done before all
+// of the input's promises settle, the returned promise immediately fulfills
+// with the partial results. The entries of the promises that are not settled
+// yet carry ctx.Err() as their Err.
+func AllSettledCtx[T any](ctx context.Context, ps ...Promise[T]) Promise[Results[T]] {
+	if len(ps) == 0 {
+		return Resolve[Results[T]](nil)
+	}
+
+	return New(func() (Results[T], error) {
+		return settleAll(ctx, ps), nil
+	})
+}
+
+func settleAll[T any](ctx context.Context, ps []Promise[T]) Results[T] {
+	agg, abort := collectResultsCtx(ctx, ps)
+	defer close(abort)
+
+	results := make(Results[T], len(ps))
+	settled := make([]bool, len(ps))
+	for r := range agg {
+		results[r.Index] = r.Result
+		settled[r.Index] = true
+	}
+
+	if err := ctx.Err(); err != nil {
+		for i := range results {
+			if !settled[i] {
+				results[i].Err = err
+			}
+		}
+	}
+	return results
+}
+
+// AllIgnoringErrors takes an array of promises and returns a single promise.
+// This returned promise never rejects: it fulfills when all of the input's
+// promises settle, with an array of the fulfillment values in the order of the
+// input. The rejected promises are skipped.
+func AllIgnoringErrors[T any](ps ...Promise[T]) Promise[[]T] {
+	return ThenMap(AllSettled(ps...), func(rs []Result[T]) []T {
+		return Results[T](rs).Values()
+	})
+}
+
+// AllSettledErr takes an array of promises and returns a single promise. This
+// returned promise never rejects: it fulfills when all of the input's promises
+// settle, with the join (see [errors.Join]) of all rejection reasons, or with
+// nil if all of the input's promises fulfill. Unlike [AllSettled], it does not
+// keep the fulfillment values.
+func AllSettledErr[T any](ps ...Promise[T]) Promise[error] {
+	if len(ps) == 0 {
+		return Resolve[error](nil)
+	}
+
+	return New(func() (error, error) {
 		agg, abort := collectResults(ps)
 		defer close(abort)
 
-		results := make([]Result[T], len(ps))
+		var errs []error
 		for r := range agg {
-			results[r.Index] = r.Result
+			if r.Err != nil {
+				errs = append(errs, r.Err)
+			}
 		}
 
-		return results, nil
+		return errors.Join(errs...), nil
 	})
 }
 
@@ -119,6 +736,14 @@ type iResult[T any] struct {
 }
 
 func collectResults[T any](ps []Promise[T]) (<-chan iResult[T], chan<- struct{}) {
+	return collectResultsCtx(context.Background(), ps)
+}
+
+// collectResultsCtx sends the results of the given promises to the returned
+// channel in the order of settlement. The waiter goroutines are terminated when
+// the abort channel is closed or the context is done. The results channel is
+// closed when all waiters are terminated.
+func collectResultsCtx[T any](ctx context.Context, ps []Promise[T]) (<-chan iResult[T], chan<- struct{}) {
 	agg := make(chan iResult[T])
 	abort := make(chan struct{})
 	wg := new(sync.WaitGroup)
@@ -130,12 +755,15 @@ func collectResults[T any](ps []Promise[T]) (<-chan iResult[T], chan<- struct{})
 			case <-p.Done():
 			case <-abort:
 				return
+			case <-ctx.Done():
+				return
 			}
 			v, e := p.Wait()
 			r := iResult[T]{i, Result[T]{v, e}}
 			select {
 			case agg <- r:
 			case <-abort:
+			case <-ctx.Done():
 			}
 		}(i, p)
 	}
@@ -1,7 +1,10 @@
 package promises_test
 
 import (
+	"context"
 	"errors"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -198,3 +201,880 @@ func (suite *AggregatesSuite) TestAllSettled() {
 	}, val)
 	suite.Nil(err)
 }
+
+// Context-aware aggregates
+
+func (suite *AggregatesSuite) TestAllCtx_resolved() {
+	p := promises.AllCtx(
+		context.Background(),
+		promises.Resolve(41),
+		promises.Resolve(42),
+	)
+	val, err := p.Wait()
+	suite.Equal([]int{41, 42}, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestAllCtx_cancel() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, _, _ := promises.WithResolvers[int]()
+
+	promise := promises.AllCtx(ctx, p1, p2)
+	resolve1(42)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	cancel()
+	val, err := promise.Wait()
+	suite.Nil(val)
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *AggregatesSuite) TestAnyCtx_cancel() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p1, _, reject1 := promises.WithResolvers[int]()
+	p2, _, _ := promises.WithResolvers[int]()
+
+	promise := promises.AnyCtx(ctx, p1, p2)
+	reject1(errors.New("some error"))
+	cancel()
+	val, err := promise.Wait()
+	suite.Zero(val)
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *AggregatesSuite) TestRaceCtx_cancel() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p1, _, _ := promises.WithResolvers[int]()
+
+	promise := promises.RaceCtx(ctx, p1)
+	cancel()
+	val, err := promise.Wait()
+	suite.Zero(val)
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *AggregatesSuite) TestAllCtx_empty() {
+	val, err := promises.AllCtx[int](context.Background()).Wait()
+	suite.Empty(val)
+	suite.Nil(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = promises.AllCtx[int](ctx).Wait()
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *AggregatesSuite) TestAnyCtx_empty() {
+	_, err := promises.AnyCtx[int](context.Background()).Wait()
+	var aggErr *promises.AggregateError
+	suite.ErrorAs(err, &aggErr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = promises.AnyCtx[int](ctx).Wait()
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *AggregatesSuite) TestRaceCtx_empty() {
+	ctx, cancel := context.WithCancel(context.Background())
+	promise := promises.RaceCtx[int](ctx)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	cancel()
+	_, err := promise.Wait()
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *AggregatesSuite) TestRaceCtx_empty_background() {
+	promise := promises.RaceCtx[int](context.Background())
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should never settle")
+}
+
+func (suite *AggregatesSuite) TestAllSettledCtx() {
+	tgtErr := errors.New("test error")
+	p := promises.AllSettledCtx(
+		context.Background(),
+		promises.Resolve(41),
+		promises.Reject[int](tgtErr),
+	)
+	val, err := p.Wait()
+	suite.Equal(promises.Results[int]{
+		{41, nil},
+		{0, tgtErr},
+	}, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestAllSettledCtx_cancel() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, _, _ := promises.WithResolvers[int]()
+	p3, _, _ := promises.WithResolvers[int]()
+
+	promise := promises.AllSettledCtx(ctx, p1, p2, p3)
+	resolve1(41)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	cancel()
+	val, err := promise.Wait()
+	suite.Nil(err)
+	suite.Equal(promises.Results[int]{
+		{41, nil},
+		{0, context.Canceled},
+		{0, context.Canceled},
+	}, val)
+}
+
+// AllProgress
+
+func (suite *AggregatesSuite) TestAllProgress() {
+	var calls [][2]int
+	p := promises.AllProgress(
+		func(done, total int) { calls = append(calls, [2]int{done, total}) },
+		promises.Resolve(41),
+		promises.Resolve(42),
+		promises.Resolve(43),
+	)
+	val, err := p.Wait()
+	suite.Equal([]int{41, 42, 43}, val)
+	suite.Nil(err)
+	suite.Equal([][2]int{{1, 3}, {2, 3}, {3, 3}}, calls)
+}
+
+func (suite *AggregatesSuite) TestAllProgress_rejected() {
+	tgtErr := errors.New("test error")
+	calls := 0
+	p := promises.AllProgress(
+		func(done, total int) { calls++ },
+		promises.Reject[int](tgtErr),
+	)
+	val, err := p.Wait()
+	suite.Nil(val)
+	suite.Equal(tgtErr, err)
+	suite.Equal(1, calls)
+}
+
+// AllSettledErr
+
+func (suite *AggregatesSuite) TestAllSettledErr_resolved() {
+	p := promises.AllSettledErr(
+		promises.Resolve(41),
+		promises.Resolve(42),
+	)
+	val, err := p.Wait()
+	suite.Nil(val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestAllSettledErr_mixed() {
+	tgtErr1 := errors.New("test error 1")
+	tgtErr2 := errors.New("test error 2")
+	p := promises.AllSettledErr(
+		promises.Reject[int](tgtErr1),
+		promises.Resolve(42),
+		promises.Reject[int](tgtErr2),
+	)
+	val, err := p.Wait()
+	suite.Nil(err)
+	suite.ErrorIs(val, tgtErr1)
+	suite.ErrorIs(val, tgtErr2)
+}
+
+// Preferred
+
+func (suite *AggregatesSuite) TestPreferred_later_settles_first() {
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, resolve2, _ := promises.WithResolvers[int]()
+
+	promise := promises.Preferred(p1, p2)
+	resolve2(43)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should wait for the preferred one")
+
+	resolve1(42)
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestPreferred_earlier_rejected() {
+	p1, _, reject1 := promises.WithResolvers[int]()
+	p2, resolve2, _ := promises.WithResolvers[int]()
+
+	promise := promises.Preferred(p1, p2)
+	resolve2(43)
+	reject1(errors.New("test error"))
+	val, err := promise.Wait()
+	suite.Equal(43, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestPreferred_all_rejected() {
+	tgtErr1 := errors.New("test error 1")
+	tgtErr2 := errors.New("test error 2")
+	p := promises.Preferred(
+		promises.Reject[int](tgtErr1),
+		promises.Reject[int](tgtErr2),
+	)
+	val, err := p.Wait()
+	suite.Zero(val)
+	var expectedErr *promises.AggregateError
+	suite.ErrorAs(err, &expectedErr)
+	suite.Equal([]error{tgtErr1, tgtErr2}, expectedErr.Errors)
+}
+
+// AllErrors
+
+func (suite *AggregatesSuite) TestAllErrors_resolved() {
+	p := promises.AllErrors(
+		promises.Resolve(41),
+		promises.Resolve(42),
+	)
+	val, err := p.Wait()
+	suite.Equal([]int{41, 42}, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestAllErrors_two_rejected() {
+	tgtErr1 := errors.New("test error 1")
+	tgtErr3 := errors.New("test error 3")
+	p := promises.AllErrors(
+		promises.Reject[int](tgtErr1),
+		promises.Resolve(42),
+		promises.Reject[int](tgtErr3),
+	)
+	val, err := p.Wait()
+	suite.Equal([]int{0, 42, 0}, val, "partial values should be available")
+	var expectedErr *promises.AggregateError
+	suite.ErrorAs(err, &expectedErr)
+	suite.Equal([]error{tgtErr1, nil, tgtErr3}, expectedErr.Errors)
+}
+
+// RaceWithLosers
+
+func (suite *AggregatesSuite) TestRaceWithLosers() {
+	tgtErr := errors.New("test error")
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, _, reject2 := promises.WithResolvers[int]()
+	p3, resolve3, _ := promises.WithResolvers[int]()
+
+	winner, losers := promises.RaceWithLosers(p1, p2, p3)
+	resolve3(43)
+	val, err := winner.Wait()
+	suite.Equal(43, val)
+	suite.Nil(err)
+
+	resolve1(41)
+	reject2(tgtErr)
+
+	var results []promises.Result[int]
+	for r := range losers {
+		results = append(results, r)
+	}
+	suite.ElementsMatch([]promises.Result[int]{
+		{41, nil},
+		{0, tgtErr},
+	}, results)
+}
+
+func (suite *AggregatesSuite) TestRaceWithLosers_empty() {
+	winner, losers := promises.RaceWithLosers[int]()
+	suite.False(isSettled(winner), "promise should not be settled")
+	_, ok := <-losers
+	suite.False(ok, "losers channel should be closed")
+}
+
+// AllStream
+
+func (suite *AggregatesSuite) TestAllStream() {
+	tgtErr := errors.New("test error")
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, _, reject2 := promises.WithResolvers[int]()
+	results, abort := promises.AllStream(0, p1, p2)
+	defer abort()
+
+	reject2(tgtErr)
+	suite.Equal(promises.Result[int]{Err: tgtErr}, <-results)
+	resolve1(42)
+	suite.Equal(promises.Result[int]{Value: 42}, <-results)
+	_, ok := <-results
+	suite.False(ok, "channel should be closed")
+}
+
+func (suite *AggregatesSuite) TestAllStream_buffer() {
+	results, abort := promises.AllStream(2,
+		promises.Resolve(41),
+		promises.Resolve(42),
+		promises.Resolve(43),
+	)
+	defer abort()
+
+	suite.Eventually(func() bool { return len(results) == 2 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	suite.Equal(2, len(results), "only bufferSize results should be buffered")
+
+	var values []int
+	for r := range results {
+		values = append(values, r.Value)
+	}
+	suite.ElementsMatch([]int{41, 42, 43}, values)
+}
+
+func (suite *AggregatesSuite) TestAllStream_abort() {
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, resolve2, _ := promises.WithResolvers[int]()
+	p3, resolve3, _ := promises.WithResolvers[int]()
+	results, abort := promises.AllStream(0, p1, p2, p3)
+
+	resolve1(41)
+	suite.Equal(promises.Result[int]{Value: 41}, <-results)
+	abort()
+	abort()
+	resolve2(42)
+	resolve3(43)
+
+	for r := range results {
+		suite.Fail("unexpected result after abort", "%v", r)
+	}
+}
+
+func (suite *AggregatesSuite) TestAllStream_empty() {
+	results, abort := promises.AllStream[int](1)
+	defer abort()
+	_, ok := <-results
+	suite.False(ok, "channel should be closed")
+}
+
+// AllUntil
+
+func (suite *AggregatesSuite) TestAllUntil() {
+	p1, _, _ := promises.WithResolvers[int]()
+	p2, _, _ := promises.WithResolvers[int]()
+	p3, _, _ := promises.WithResolvers[int]()
+	calls := 0
+	val, err := promises.AllUntil(
+		func(collected []int) bool { calls++; return len(collected) >= 2 },
+		p1, promises.Resolve(41), p2, promises.Resolve(42), p3,
+	).Wait()
+	suite.ElementsMatch([]int{41, 42}, val)
+	suite.Nil(err)
+	suite.Equal(2, calls)
+}
+
+func (suite *AggregatesSuite) TestAllUntil_never_stops() {
+	val, err := promises.AllUntil(
+		func([]int) bool { return false },
+		promises.Resolve(41), promises.Resolve(42),
+	).Wait()
+	suite.ElementsMatch([]int{41, 42}, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestAllUntil_rejected() {
+	tgtErr := errors.New("test error")
+	p1, _, _ := promises.WithResolvers[int]()
+	val, err := promises.AllUntil(
+		func(collected []int) bool { return len(collected) >= 2 },
+		p1, promises.Reject[int](tgtErr),
+	).Wait()
+	suite.Nil(val)
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *AggregatesSuite) TestAllUntil_empty() {
+	val, err := promises.AllUntil(func([]int) bool { return true }).Wait()
+	suite.Empty(val)
+	suite.Nil(err)
+}
+
+// Combine
+
+func (suite *AggregatesSuite) TestCombine() {
+	val, err := promises.Combine(
+		[]promises.Promise[string]{promises.Resolve("a"), promises.Resolve("b")},
+		[]promises.Promise[int]{promises.Resolve(1), promises.Resolve(2), promises.Resolve(3)},
+		func(a string, b int) (string, error) { return a + strconv.Itoa(b), nil },
+	).Wait()
+	suite.Equal([]string{"a1", "a2", "a3", "b1", "b2", "b3"}, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestCombine_fn_error() {
+	tgtErr := errors.New("test error")
+	var calls []string
+	val, err := promises.Combine(
+		[]promises.Promise[string]{promises.Resolve("a"), promises.Resolve("b")},
+		[]promises.Promise[int]{promises.Resolve(1), promises.Resolve(2)},
+		func(a string, b int) (string, error) {
+			pair := a + strconv.Itoa(b)
+			calls = append(calls, pair)
+			if pair == "a2" {
+				return "", tgtErr
+			}
+			return pair, nil
+		},
+	).Wait()
+	suite.Nil(val)
+	suite.ErrorIs(err, tgtErr)
+	suite.Equal([]string{"a1", "a2"}, calls, "fn should not be called after the error")
+}
+
+func (suite *AggregatesSuite) TestCombine_rejected() {
+	tgtErr := errors.New("test error")
+	val, err := promises.Combine(
+		[]promises.Promise[string]{promises.Resolve("a")},
+		[]promises.Promise[int]{promises.Resolve(1), promises.Reject[int](tgtErr)},
+		func(a string, b int) (string, error) {
+			suite.Fail("fn should not be called")
+			return "", nil
+		},
+	).Wait()
+	suite.Nil(val)
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *AggregatesSuite) TestCombine_empty() {
+	val, err := promises.Combine(
+		[]promises.Promise[string]{promises.Resolve("a")},
+		nil,
+		func(a string, b int) (string, error) { return a, nil },
+	).Wait()
+	suite.Empty(val)
+	suite.Nil(err)
+}
+
+// ScatterGather
+
+func (suite *AggregatesSuite) TestScatterGather() {
+	tgtErr := errors.New("test error")
+	slow, _, _ := promises.WithResolvers[int]()
+	val, err := promises.ScatterGather(10*time.Millisecond,
+		promises.Resolve(41),
+		slow,
+		promises.Reject[int](tgtErr),
+	).Wait()
+	suite.Nil(err)
+	suite.Require().Len(val, 3)
+	suite.Equal(promises.Result[int]{Value: 41}, val[0])
+	suite.ErrorIs(val[1].Err, context.DeadlineExceeded)
+	suite.ErrorIs(val[2].Err, tgtErr)
+	suite.Equal([]int{41}, val.Values())
+}
+
+func (suite *AggregatesSuite) TestScatterGather_empty() {
+	val, err := promises.ScatterGather[int](time.Second).Wait()
+	suite.Empty(val)
+	suite.Nil(err)
+}
+
+// Min and Max
+
+func (suite *AggregatesSuite) TestMin() {
+	val, err := promises.Min(promises.Resolve(42), promises.Resolve(-3), promises.Resolve(7)).Wait()
+	suite.Equal(-3, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestMax() {
+	val, err := promises.Max(promises.Resolve("b"), promises.Resolve("c"), promises.Resolve("a")).Wait()
+	suite.Equal("c", val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestMin_rejected() {
+	tgtErr := errors.New("test error")
+	val, err := promises.Min(promises.Resolve(42), promises.Reject[int](tgtErr)).Wait()
+	suite.Zero(val)
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *AggregatesSuite) TestMax_empty() {
+	_, err := promises.Max[int]().Wait()
+	suite.ErrorIs(err, promises.ErrNoPromises)
+}
+
+// AllWithItemTimeout
+
+func (suite *AggregatesSuite) TestAllWithItemTimeout() {
+	p := promises.AllWithItemTimeout(
+		time.Second,
+		promises.Resolve(41),
+		promises.Resolve(42),
+	)
+	val, err := p.Wait()
+	suite.Equal([]int{41, 42}, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestAllWithItemTimeout_slow() {
+	slow, resolveSlow, _ := promises.WithResolvers[int]()
+	defer resolveSlow(0)
+
+	p := promises.AllWithItemTimeout(
+		10*time.Millisecond,
+		promises.Resolve(41),
+		slow,
+	)
+	val, err := p.Wait()
+	suite.Nil(val)
+	suite.ErrorIs(err, context.DeadlineExceeded)
+}
+
+// WaitCount
+
+func (suite *AggregatesSuite) TestWaitCount() {
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, _, reject2 := promises.WithResolvers[int]()
+	p3, _, _ := promises.WithResolvers[int]()
+
+	promise := promises.WaitCount(2, p1, p2, p3)
+	resolve1(41)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	reject2(errors.New("test error"))
+	_, err := promise.Wait()
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestWaitCount_zero() {
+	promise := promises.WaitCount[int](0)
+	suite.True(isSettled(promise), "promise should be settled")
+	_, err := promise.Wait()
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestWaitCount_too_many() {
+	_, err := promises.WaitCount(3, promises.Resolve(41), promises.Resolve(42)).Wait()
+	suite.Error(err)
+}
+
+// AnyDistinct
+
+func (suite *AggregatesSuite) TestAnyDistinct() {
+	var seen sync.Map
+	seen.Store(41, struct{}{})
+
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, resolve2, _ := promises.WithResolvers[int]()
+	promise := promises.AnyDistinct(&seen, p1, p2)
+	resolve1(41)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "duplicate value should be skipped")
+
+	resolve2(42)
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+
+	_, loaded := seen.Load(42)
+	suite.True(loaded, "winner should be added to the seen set")
+}
+
+func (suite *AggregatesSuite) TestAnyDistinct_shared() {
+	var seen sync.Map
+	val, err := promises.AnyDistinct(&seen, promises.Resolve(42)).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+
+	tgtErr := errors.New("test error")
+	val, err = promises.AnyDistinct(&seen, promises.Resolve(42), promises.Reject[int](tgtErr)).Wait()
+	suite.Zero(val)
+	suite.Equal(&promises.AggregateError{Errors: []error{promises.ErrDuplicate, tgtErr}}, err)
+}
+
+func (suite *AggregatesSuite) TestAnyDistinct_empty() {
+	var seen sync.Map
+	_, err := promises.AnyDistinct[int](&seen).Wait()
+	var aggErr *promises.AggregateError
+	suite.ErrorAs(err, &aggErr)
+}
+
+// FirstWhere
+
+func (suite *AggregatesSuite) TestFirstWhere() {
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, resolve2, _ := promises.WithResolvers[int]()
+	p3, _, reject3 := promises.WithResolvers[int]()
+
+	promise := promises.FirstWhere(func(v int) bool { return v > 41 }, p1, p2, p3)
+	resolve1(41)
+	reject3(errors.New("test error"))
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	resolve2(42)
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestFirstWhere_no_match() {
+	tgtErr := errors.New("test error")
+	p := promises.FirstWhere(
+		func(v int) bool { return v > 41 },
+		promises.Resolve(41),
+		promises.Reject[int](tgtErr),
+	)
+	val, err := p.Wait()
+	suite.Zero(val)
+	var expectedErr *promises.AggregateError
+	suite.ErrorAs(err, &expectedErr)
+	suite.Equal([]error{promises.ErrNoMatch, tgtErr}, expectedErr.Errors)
+	suite.ErrorIs(err, promises.ErrNoMatch)
+}
+
+// AllWithFailures
+
+func (suite *AggregatesSuite) TestAllWithFailures() {
+	tgtErr1 := errors.New("test error 1")
+	tgtErr3 := errors.New("test error 3")
+	values, failures := promises.AllWithFailures(
+		promises.Reject[int](tgtErr1),
+		promises.Resolve(42),
+		promises.Reject[int](tgtErr3),
+		promises.Resolve(43),
+	)
+	suite.Equal([]int{0, 42, 0, 43}, values)
+	suite.Equal(map[int]error{0: tgtErr1, 2: tgtErr3}, failures)
+}
+
+func (suite *AggregatesSuite) TestAllWithFailures_resolved() {
+	values, failures := promises.AllWithFailures(
+		promises.Resolve(41),
+		promises.Resolve(42),
+	)
+	suite.Equal([]int{41, 42}, values)
+	suite.Empty(failures)
+}
+
+// AllInOrderOfCompletion
+
+func (suite *AggregatesSuite) TestAllInOrderOfCompletion() {
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, resolve2, _ := promises.WithResolvers[int]()
+	p3, resolve3, _ := promises.WithResolvers[int]()
+
+	promise := promises.AllInOrderOfCompletion(p1, p2, p3)
+	resolve3(43)
+	time.Sleep(10 * time.Millisecond)
+	resolve1(41)
+	time.Sleep(10 * time.Millisecond)
+	resolve2(42)
+
+	val, err := promise.Wait()
+	suite.Equal([]int{43, 41, 42}, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestAllInOrderOfCompletion_rejected() {
+	tgtErr := errors.New("test error")
+	p := promises.AllInOrderOfCompletion(
+		promises.Resolve(41),
+		promises.Reject[int](tgtErr),
+	)
+	val, err := p.Wait()
+	suite.Nil(val)
+	suite.Equal(tgtErr, err)
+}
+
+// AllIgnoringErrors
+
+func (suite *AggregatesSuite) TestAllIgnoringErrors() {
+	p := promises.AllIgnoringErrors(
+		promises.Resolve(41),
+		promises.Reject[int](errors.New("test error 1")),
+		promises.Resolve(42),
+		promises.Reject[int](errors.New("test error 2")),
+		promises.Resolve(43),
+	)
+	val, err := p.Wait()
+	suite.Equal([]int{41, 42, 43}, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestAllIgnoringErrors_all_rejected() {
+	p := promises.AllIgnoringErrors(
+		promises.Reject[int](errors.New("test error")),
+	)
+	val, err := p.Wait()
+	suite.Empty(val)
+	suite.Nil(err)
+}
+
+// FirstSuccess
+
+func (suite *AggregatesSuite) TestFirstSuccess() {
+	release := make(chan struct{})
+	defer close(release)
+
+	p := promises.FirstSuccess(
+		func() (int, error) { return 0, errors.New("test error") },
+		func() (int, error) { <-release; return 41, nil },
+		func() (int, error) { return 42, nil },
+	)
+	val, err := p.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestFirstSuccess_all_fail() {
+	tgtErr1 := errors.New("test error 1")
+	tgtErr2 := errors.New("test error 2")
+	p := promises.FirstSuccess(
+		func() (int, error) { return 0, tgtErr1 },
+		func() (int, error) { return 0, tgtErr2 },
+	)
+	val, err := p.Wait()
+	suite.Zero(val)
+	var expectedErr *promises.AggregateError
+	suite.ErrorAs(err, &expectedErr)
+	suite.Equal([]error{tgtErr1, tgtErr2}, expectedErr.Errors)
+}
+
+// FirstNonPanic
+
+func (suite *AggregatesSuite) TestFirstNonPanic() {
+	p := promises.FirstNonPanic(
+		func() int { panic("first") },
+		func() int { panic("second") },
+		func() int { return 42 },
+	)
+	val, err := p.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestFirstNonPanic_all_panic() {
+	p := promises.FirstNonPanic(
+		func() int { panic("first") },
+		func() int { panic("second") },
+	)
+	val, err := p.Wait()
+	suite.Zero(val)
+	var aggErr *promises.AggregateError
+	suite.Require().ErrorAs(err, &aggErr)
+	suite.Len(aggErr.Errors, 2)
+	for i, want := range []string{"first", "second"} {
+		var panicErr *promises.ErrPanic
+		suite.Require().ErrorAs(aggErr.Errors[i], &panicErr)
+		suite.Equal(want, panicErr.Value)
+	}
+}
+
+// RaceResult
+
+func (suite *AggregatesSuite) TestRaceResult_rejected() {
+	tgtErr := errors.New("test error")
+	p1, _, _ := promises.WithResolvers[int]()
+
+	val, err := promises.RaceResult(p1, promises.Reject[int](tgtErr)).Wait()
+	suite.Equal(promises.Result[int]{Err: tgtErr}, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestRaceResult_zero_value() {
+	p1, _, _ := promises.WithResolvers[int]()
+
+	val, err := promises.RaceResult(p1, promises.Resolve(0)).Wait()
+	suite.Equal(promises.Result[int]{Value: 0}, val)
+	suite.Nil(err)
+}
+
+// RaceOutcome
+
+func (suite *AggregatesSuite) TestRaceOutcome() {
+	tgtErr := errors.New("test error")
+	p1, _, reject1 := promises.WithResolvers[int]()
+	p2, resolve2, _ := promises.WithResolvers[int]()
+
+	promise := promises.RaceOutcome(p1, p2)
+	resolve2(42)
+	report, err := promise.Wait()
+	suite.Nil(err)
+	suite.Equal(1, report.Index)
+	suite.Equal(promises.Result[int]{Value: 42}, report.Result)
+	suite.False(isSettled(report.Others), "others should not be settled")
+
+	reject1(tgtErr)
+	others, err := report.Others.Wait()
+	suite.Nil(err)
+	suite.Equal(promises.Results[int]{{0, tgtErr}, {42, nil}}, others)
+}
+
+// WaitAny
+
+func (suite *AggregatesSuite) TestWaitAny() {
+	p1, _, _ := promises.WithResolvers[int]()
+	p2, resolve2, _ := promises.WithResolvers[int]()
+	p3 := promises.New(func() (int, error) {
+		time.Sleep(time.Second)
+		return 43, nil
+	})
+
+	resolve2(42)
+	idx, val, err := promises.WaitAny(p1, p2, p3)
+	suite.Equal(1, idx)
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestWaitAny_rejected() {
+	tgtErr := errors.New("test error")
+	p1, _, _ := promises.WithResolvers[int]()
+	idx, val, err := promises.WaitAny(p1, promises.Reject[int](tgtErr))
+	suite.Equal(1, idx)
+	suite.Zero(val)
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *AggregatesSuite) TestWaitAny_empty() {
+	idx, val, err := promises.WaitAny[int]()
+	suite.Equal(-1, idx)
+	suite.Zero(val)
+	suite.Nil(err)
+}
+
+// AllTolerant
+
+func (suite *AggregatesSuite) TestAllTolerant_underThreshold() {
+	tgtErr := errors.New("test error")
+	val, err := promises.AllTolerant(1,
+		promises.Resolve(41),
+		promises.Reject[int](tgtErr),
+		promises.Resolve(43),
+	).Wait()
+	suite.Equal([]int{41, 0, 43}, val)
+	suite.Nil(err)
+}
+
+func (suite *AggregatesSuite) TestAllTolerant_overThreshold() {
+	tgtErr1 := errors.New("test error 1")
+	tgtErr2 := errors.New("test error 2")
+	pending, _, _ := promises.WithResolvers[int]()
+	val, err := promises.AllTolerant(1,
+		promises.Reject[int](tgtErr1),
+		pending,
+		promises.Reject[int](tgtErr2),
+	).Wait()
+	suite.Nil(val)
+	suite.Equal(&promises.AggregateError{Errors: []error{tgtErr1, nil, tgtErr2}}, err)
+}
+
+func (suite *AggregatesSuite) TestAllTolerant_zero() {
+	tgtErr := errors.New("test error")
+	val, err := promises.AllTolerant(0, promises.Resolve(42), promises.Reject[int](tgtErr)).Wait()
+	suite.Nil(val)
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *AggregatesSuite) TestAllTolerant_empty() {
+	val, err := promises.AllTolerant[int](0).Wait()
+	suite.Empty(val)
+	suite.Nil(err)
+}
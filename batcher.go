@@ -0,0 +1,79 @@
+package promises
+
+import (
+	"sync"
+	"time"
+)
+
+// Batcher accumulates the values passed to its Add method and flushes them
+// together when either maxSize values are accumulated or maxWait elapses since
+// the first value of the batch was added. All the Add promises of the batch
+// settle with the flush outcome.
+type Batcher[T any] struct {
+	maxSize int
+	maxWait time.Duration
+	flush   func([]T) error
+
+	mu      sync.Mutex
+	items   []T
+	promise Promise[struct{}]
+	resolve func(struct{})
+	reject  func(error)
+	timer   *time.Timer
+	batch   uint64
+}
+
+// NewBatcher creates a new [Batcher]. If maxSize is not positive, the batches
+// are flushed only by time; if maxWait is not positive, the batches are flushed
+// only by size. The flush function is called in a separate goroutine, so the
+// flushes of different batches can run concurrently.
+func NewBatcher[T any](maxSize int, maxWait time.Duration, flush func([]T) error) *Batcher[T] {
+	return &Batcher[T]{
+		maxSize: maxSize,
+		maxWait: maxWait,
+		flush:   flush,
+	}
+}
+
+// Add adds the value to the current batch and returns a promise that settles
+// when the batch is flushed: it resolves if the flush function returns nil,
+// and rejects with its error otherwise.
+func (b *Batcher[T]) Add(v T) Promise[struct{}] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.items) == 0 {
+		b.promise, b.resolve, b.reject = WithResolvers[struct{}]()
+		if b.maxWait > 0 {
+			batch := b.batch
+			b.timer = time.AfterFunc(b.maxWait, func() {
+				b.mu.Lock()
+				defer b.mu.Unlock()
+				if b.batch == batch {
+					b.flushLocked()
+				}
+			})
+		}
+	}
+
+	b.items = append(b.items, v)
+	promise := b.promise
+	if b.maxSize > 0 && len(b.items) >= b.maxSize {
+		b.flushLocked()
+	}
+	return promise
+}
+
+func (b *Batcher[T]) flushLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	items, resolve, reject := b.items, b.resolve, b.reject
+	b.items, b.promise, b.resolve, b.reject = nil, nil, nil, nil
+	b.batch++
+
+	go runGen(func() (struct{}, error) {
+		return struct{}{}, b.flush(items)
+	}, resolve, reject)
+}
@@ -0,0 +1,79 @@
+package promises_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBatcherSuite(t *testing.T) {
+	suite.Run(t, new(BatcherSuite))
+}
+
+type BatcherSuite struct {
+	suite.Suite
+}
+
+type flushRecorder struct {
+	mu      sync.Mutex
+	batches [][]int
+	err     error
+}
+
+func (r *flushRecorder) flush(items []int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, items)
+	return r.err
+}
+
+func (suite *BatcherSuite) TestAdd_size() {
+	rec := new(flushRecorder)
+	b := promises.NewBatcher(2, time.Hour, rec.flush)
+
+	p1 := b.Add(1)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(p1), "promise should not be settled")
+
+	p2 := b.Add(2)
+	p3 := b.Add(3)
+	_, err := promises.All(p1, p2).Wait()
+	suite.Nil(err)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(p3), "promise of the next batch should not be settled")
+
+	p4 := b.Add(4)
+	_, err = promises.All(p3, p4).Wait()
+	suite.Nil(err)
+	suite.ElementsMatch([][]int{{1, 2}, {3, 4}}, rec.batches)
+}
+
+func (suite *BatcherSuite) TestAdd_time() {
+	rec := new(flushRecorder)
+	b := promises.NewBatcher(10, 20*time.Millisecond, rec.flush)
+
+	start := time.Now()
+	p1 := b.Add(1)
+	p2 := b.Add(2)
+	_, err := promises.All(p1, p2).Wait()
+	suite.Nil(err)
+	suite.GreaterOrEqual(time.Since(start), 20*time.Millisecond)
+	suite.Equal([][]int{{1, 2}}, rec.batches)
+}
+
+func (suite *BatcherSuite) TestAdd_error() {
+	tgtErr := errors.New("test error")
+	rec := &flushRecorder{err: tgtErr}
+	b := promises.NewBatcher(2, time.Hour, rec.flush)
+
+	p1 := b.Add(1)
+	p2 := b.Add(2)
+	_, err := p1.Wait()
+	suite.Equal(tgtErr, err)
+	_, err = p2.Wait()
+	suite.Equal(tgtErr, err)
+}
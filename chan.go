@@ -0,0 +1,27 @@
+package promises
+
+import "context"
+
+// Send creates a promise that sends v to the channel in a separate goroutine,
+// and resolves once the value is sent. If the channel is full (or unbuffered
+// and nobody receives), the promise stays pending.
+//
+// Note that wrapping the promise in [WithContext] lets the caller give up
+// waiting, but does not stop the sending goroutine, so the value can still be
+// sent later. Use [SendCtx] to stop sending on the context cancellation.
+func Send[T any](ch chan<- T, v T) Promise[struct{}] {
+	return SendCtx(context.Background(), ch, v)
+}
+
+// SendCtx acts like [Send], but stops sending and rejects with ctx.Err() if the
+// context is done before the value is sent.
+func SendCtx[T any](ctx context.Context, ch chan<- T, v T) Promise[struct{}] {
+	return NewVoid(func() error {
+		select {
+		case ch <- v:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
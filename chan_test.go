@@ -0,0 +1,52 @@
+package promises_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestChanSuite(t *testing.T) {
+	suite.Run(t, new(ChanSuite))
+}
+
+type ChanSuite struct {
+	suite.Suite
+}
+
+func (suite *ChanSuite) TestSend_buffered() {
+	ch := make(chan int, 1)
+	_, err := promises.Send(ch, 42).Wait()
+	suite.Nil(err)
+	suite.Equal(42, <-ch)
+}
+
+func (suite *ChanSuite) TestSend_unbuffered() {
+	ch := make(chan int)
+	promise := promises.Send(ch, 42)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	suite.Equal(42, <-ch)
+	_, err := promise.Wait()
+	suite.Nil(err)
+}
+
+func (suite *ChanSuite) TestSendCtx_cancel() {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int)
+	promise := promises.SendCtx(ctx, ch, 42)
+
+	cancel()
+	_, err := promise.Wait()
+	suite.ErrorIs(err, context.Canceled)
+
+	select {
+	case <-ch:
+		suite.Fail("value should not be sent")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
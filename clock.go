@@ -0,0 +1,64 @@
+package promises
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Clock is the source of time for [Promise.WaitFor] (and so [WithTimeout] and
+// the helpers built on it), the [Retryer] delays (including the ones of
+// [RetryingPool]) and [ThenTimed]. It can be replaced with [SetClock] to drive
+// these helpers by a fake clock in tests.
+//
+// The helpers based on tickers and deferred calls always use the real time:
+// [FromAtomic], [FromSyncMap], [AfterTicks], [Batcher], [RateLimiter] and
+// [FromFSWatch].
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel that receives the current time after d.
+	After(d time.Duration) <-chan time.Time
+	// NewTimer creates a timer that fires once after d.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single-shot timer created by [Clock].
+type Timer interface {
+	// C returns the channel that receives the time when the timer fires.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing, see [time.Timer.Stop].
+	Stop() bool
+}
+
+var clock atomic.Pointer[Clock]
+
+// SetClock replaces the package clock. The nil clock restores the real one
+// (the default). It is intended for tests: the timers already started by the
+// previous clock are not affected.
+func SetClock(c Clock) {
+	if c == nil {
+		clock.Store(nil)
+		return
+	}
+	clock.Store(&c)
+}
+
+func currentClock() Clock {
+	if c := clock.Load(); c != nil {
+		return *c
+	}
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+func (realClock) NewTimer(d time.Duration) Timer         { return realTimer{time.NewTimer(d)} }
+
+type realTimer struct {
+	t *time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time { return t.t.C }
+func (t realTimer) Stop() bool          { return t.t.Stop() }
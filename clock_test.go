@@ -0,0 +1,187 @@
+package promises_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestClockSuite(t *testing.T) {
+	suite.Run(t, new(ClockSuite))
+}
+
+type ClockSuite struct {
+	suite.Suite
+	clock *fakeClock
+}
+
+func (suite *ClockSuite) SetupTest() {
+	suite.clock = newFakeClock()
+	promises.SetClock(suite.clock)
+}
+
+func (suite *ClockSuite) TearDownTest() {
+	promises.SetClock(nil)
+}
+
+// waitTimers waits for the n pending timers of the fake clock.
+func (suite *ClockSuite) waitTimers(n int) {
+	suite.Require().Eventually(func() bool {
+		return suite.clock.Pending() == n
+	}, time.Second, time.Millisecond)
+}
+
+func (suite *ClockSuite) TestWaitFor_timeout() {
+	promise, _, _ := promises.WithResolvers[int]()
+	errs := make(chan error, 1)
+	go func() {
+		_, err := promise.WaitFor(time.Hour)
+		errs <- err
+	}()
+
+	suite.waitTimers(1)
+	suite.clock.Advance(time.Hour - time.Second)
+	suite.Empty(errs, "WaitFor should not time out yet")
+
+	suite.clock.Advance(time.Second)
+	err := <-errs
+	suite.ErrorIs(err, promises.ErrTimeout)
+	suite.EqualError(err, "promise timed out after 1h0m0s")
+}
+
+func (suite *ClockSuite) TestWaitFor_settled() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	results := make(chan int, 1)
+	go func() {
+		v, _ := promise.WaitFor(time.Hour)
+		results <- v
+	}()
+
+	suite.waitTimers(1)
+	resolve(42)
+	suite.Equal(42, <-results)
+	suite.waitTimers(0)
+}
+
+func (suite *ClockSuite) TestRetryer() {
+	fn, calls := failingTimes(2, 42)
+	r := promises.Retryer{MaxAttempts: 3, Backoff: time.Minute}
+	promise := promises.RunRetryer(r, fn)
+
+	suite.waitTimers(1)
+	suite.clock.Advance(time.Minute)
+	suite.waitTimers(1)
+	suite.False(isSettled(promise), "promise should wait for the second delay")
+	suite.clock.Advance(2 * time.Minute)
+
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+	suite.Equal(3, *calls)
+}
+
+func (suite *ClockSuite) TestThenTimed() {
+	var recorded time.Duration
+	promises.SetMetricsSink(func(_ string, d time.Duration, _ error) { recorded = d })
+	defer promises.SetMetricsSink(nil)
+
+	_, err := promises.ThenTimed(promises.Resolve(1), "step", func(v int) (int, error) {
+		suite.clock.Advance(5 * time.Second)
+		return v, errors.New("test error")
+	}).Wait()
+	suite.Error(err)
+	suite.Equal(5*time.Second, recorded)
+}
+
+// fakeClock is a manually advanced [promises.Clock].
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock *fakeClock
+	at    time.Time
+	c     chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) promises.Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{clock: c, at: c.now.Add(d), c: make(chan time.Time, 1)}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the clock forward and fires the expired timers.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	pending := c.timers[:0]
+	for _, t := range c.timers {
+		if t.at.After(c.now) {
+			pending = append(pending, t)
+		} else {
+			t.c <- c.now
+		}
+	}
+	c.timers = pending
+}
+
+// Pending returns the number of timers that are not fired or stopped yet.
+func (c *fakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.c }
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	for i, other := range t.clock.timers {
+		if other == t {
+			t.clock.timers = append(t.clock.timers[:i], t.clock.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *ClockSuite) TestRetryingPool() {
+	pool := promises.NewRetryingPool(1, promises.Retryer{MaxAttempts: 2, Backoff: time.Hour})
+	defer pool.Close()
+
+	fn, calls := failingTimes(1, 42)
+	promise := promises.SubmitRetrying(pool, fn)
+
+	suite.waitTimers(1)
+	suite.False(isSettled(promise), "promise should wait for the backoff")
+	suite.clock.Advance(time.Hour)
+
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+	suite.Equal(2, *calls)
+}
@@ -1,6 +1,11 @@
 package promises
 
-import "context"
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+)
 
 // Ctx creates a promise from a given context. It never resolves, and
 // only rejects if the context is done.
@@ -17,5 +22,105 @@ func Ctx[T any](ctx context.Context) Promise[T] {
 // WithContext creates a race between a given promise and a context. It is a
 // shortcut for Race(promise, Ctx[T](ctx)).
 func WithContext[T any](ctx context.Context, promise Promise[T]) Promise[T] {
-	return Race(promise, Ctx[T](ctx))
+	return Race(promise, untracked(Ctx[T](ctx)))
+}
+
+// WithContextPreferValue acts like [WithContext], but if the promise and the
+// context settle at virtually the same time, it prefers the promise's
+// fulfillment value over the context error. It is a best-effort check: the
+// promise's state is inspected once after the race is lost to the context.
+func WithContextPreferValue[T any](ctx context.Context, promise Promise[T]) Promise[T] {
+	return New(func() (T, error) {
+		v, err := WithContext(ctx, promise).Wait()
+		if err == nil || ctx.Err() == nil {
+			return v, err
+		}
+		select {
+		case <-promise.Done():
+			if pv, ok := promise.WaitOk(); ok {
+				return pv, nil
+			}
+		default:
+		}
+		return v, err
+	})
+}
+
+// WithTimeout creates a race between a given promise and a timeout. If the
+// promise doesn't settle within d, the returned promise rejects with a
+// [TimeoutError], that matches both [ErrTimeout] and [context.DeadlineExceeded].
+func WithTimeout[T any](d time.Duration, promise Promise[T]) Promise[T] {
+	return New(func() (T, error) { return promise.WaitFor(d) })
+}
+
+// ContextDone describes the context that is done first, see [FirstContext].
+type ContextDone struct {
+	// Index is the index of the context in the FirstContext arguments.
+	Index int
+	// Err is the error of the context (see [context.Context.Err]).
+	Err error
+}
+
+// FirstContext creates a promise that resolves when the first of the given
+// contexts is done, with the index of this context and its error. It never
+// rejects, and never settles if no contexts are given or none of them is ever
+// done.
+func FirstContext(ctxs ...context.Context) Promise[ContextDone] {
+	if len(ctxs) == 0 {
+		p, _, _ := WithResolvers[ContextDone]()
+		return p
+	}
+
+	cases := make([]reflect.SelectCase, len(ctxs))
+	for i, ctx := range ctxs {
+		cases[i] = reflect.SelectCase{
+			Dir:  reflect.SelectRecv,
+			Chan: reflect.ValueOf(ctx.Done()),
+		}
+	}
+	return New(func() (ContextDone, error) {
+		i, _, _ := reflect.Select(cases)
+		return ContextDone{i, ctxs[i].Err()}, nil
+	})
+}
+
+// DeadlinePromise creates a promise that resolves with 0 when the deadline of
+// the context passes, or rejects with ctx.Err() if the context is canceled
+// before that. If the context has no deadline, the promise can only reject on
+// cancellation. Use [TimeUntilDeadline] to get the remaining time.
+func DeadlinePromise(ctx context.Context) Promise[time.Duration] {
+	return New(func() (time.Duration, error) {
+		<-ctx.Done()
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return 0, nil
+		}
+		return 0, ctx.Err()
+	})
+}
+
+// TimeUntilDeadline returns the time remaining until the deadline of the
+// context (zero if it has passed) and true, or zero and false if the context
+// has no deadline.
+func TimeUntilDeadline(ctx context.Context) (time.Duration, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	return max(time.Until(deadline), 0), true
+}
+
+// Shutdown waits for all the given tasks to settle or for the context to be
+// done. It returns ctx.Err() if the context is done first, and the join (see
+// [errors.Join]) of the task errors otherwise. It is designed for the graceful
+// server shutdown, where several background loops expose their completion
+// promises.
+func Shutdown(ctx context.Context, tasks ...Promise[struct{}]) error {
+	done := untracked(AllSettledErr(tasks...))
+	select {
+	case <-done.Done():
+		err, _ := done.Wait()
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
@@ -2,7 +2,9 @@ package promises_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/davidmz/go-promises"
 	"github.com/stretchr/testify/suite"
@@ -61,3 +63,168 @@ func (suite *ContextSuite) TestWithContext_cancel() {
 	suite.Equal(0, val, "promise value should be zero")
 	suite.ErrorIs(err, context.Canceled, "error should be context.Canceled")
 }
+
+func (suite *ContextSuite) TestWithTimeout_resolve() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	promise = promises.WithTimeout(time.Second, promise)
+
+	resolve(42)
+	val, err := promise.Wait()
+	suite.Equal(42, val, "promise should resolve with correct value")
+	suite.Nil(err, "error should be nil")
+}
+
+func (suite *ContextSuite) TestWithTimeout_timeout() {
+	promise, _, _ := promises.WithResolvers[int]()
+	promise = promises.WithTimeout(10*time.Millisecond, promise)
+
+	val, err := promise.Wait()
+	suite.Equal(0, val, "promise value should be zero")
+	suite.ErrorIs(err, context.DeadlineExceeded, "error should be context.DeadlineExceeded")
+}
+
+func (suite *ContextSuite) TestFirstContext() {
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	defer cancel1()
+	ctx2, cancel2 := context.WithCancel(context.Background())
+
+	promise := promises.FirstContext(ctx1, ctx2)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	cancel2()
+	val, err := promise.Wait()
+	suite.Nil(err)
+	suite.Equal(1, val.Index)
+	suite.ErrorIs(val.Err, context.Canceled)
+}
+
+func (suite *ContextSuite) TestFirstContext_deadline() {
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	defer cancel1()
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel2()
+
+	val, err := promises.FirstContext(ctx1, ctx2).Wait()
+	suite.Nil(err)
+	suite.Equal(1, val.Index)
+	suite.ErrorIs(val.Err, context.DeadlineExceeded)
+}
+
+func (suite *ContextSuite) TestWithContextPreferValue() {
+	for i := 0; i < 100; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		promise, resolve, _ := promises.WithResolvers[int]()
+		resolve(42)
+		cancel()
+
+		val, err := promises.WithContextPreferValue(ctx, promise).Wait()
+		suite.Equal(42, val, "promise should prefer value")
+		suite.Nil(err, "error should be nil")
+	}
+}
+
+func (suite *ContextSuite) TestWithContextPreferValue_cancel() {
+	ctx, cancel := context.WithCancel(context.Background())
+	promise, _, _ := promises.WithResolvers[int]()
+
+	promise = promises.WithContextPreferValue(ctx, promise)
+	cancel()
+
+	val, err := promise.Wait()
+	suite.Equal(0, val, "promise value should be zero")
+	suite.ErrorIs(err, context.Canceled, "error should be context.Canceled")
+}
+
+func (suite *ContextSuite) TestWithContextPreferValue_rejected() {
+	tgtErr := errors.New("some error")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := promises.WithContextPreferValue(ctx, promises.Reject[int](tgtErr)).Wait()
+	suite.Error(err, "rejection should not be replaced")
+}
+
+func (suite *ContextSuite) TestDeadlinePromise() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	val, err := promises.DeadlinePromise(ctx).Wait()
+	suite.Zero(val)
+	suite.Nil(err)
+}
+
+func (suite *ContextSuite) TestDeadlinePromise_canceled() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	promise := promises.DeadlinePromise(ctx)
+	cancel()
+
+	_, err := promise.Wait()
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *ContextSuite) TestDeadlinePromise_no_deadline() {
+	ctx, cancel := context.WithCancel(context.Background())
+	promise := promises.DeadlinePromise(ctx)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	cancel()
+	_, err := promise.Wait()
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *ContextSuite) TestTimeUntilDeadline() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	d, ok := promises.TimeUntilDeadline(ctx)
+	suite.True(ok)
+	suite.InDelta(time.Hour, d, float64(time.Minute))
+}
+
+func (suite *ContextSuite) TestTimeUntilDeadline_passed() {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	d, ok := promises.TimeUntilDeadline(ctx)
+	suite.True(ok)
+	suite.Zero(d)
+}
+
+func (suite *ContextSuite) TestTimeUntilDeadline_no_deadline() {
+	d, ok := promises.TimeUntilDeadline(context.Background())
+	suite.False(ok)
+	suite.Zero(d)
+}
+
+func (suite *ContextSuite) TestShutdown() {
+	tgtErr := errors.New("some error")
+	t1, resolve1, _ := promises.WithResolvers[struct{}]()
+	t2, _, reject2 := promises.WithResolvers[struct{}]()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		resolve1(struct{}{})
+		reject2(tgtErr)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err := promises.Shutdown(ctx, t1, t2)
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *ContextSuite) TestShutdown_completed() {
+	err := promises.Shutdown(context.Background(), promises.Resolve(struct{}{}))
+	suite.Nil(err)
+}
+
+func (suite *ContextSuite) TestShutdown_deadline() {
+	t1, _, _ := promises.WithResolvers[struct{}]()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := promises.Shutdown(ctx, t1, promises.Resolve(struct{}{}))
+	suite.ErrorIs(err, context.DeadlineExceeded)
+}
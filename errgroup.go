@@ -0,0 +1,12 @@
+package promises
+
+// ErrGroupAdapter returns a function that waits for all of the given promises
+// and returns the first rejection reason (see [All]), or nil if all of them
+// fulfill. The function is suitable for the Go method of errgroup.Group, so the
+// promise-based work can be added to the existing error groups.
+func ErrGroupAdapter[T any](ps ...Promise[T]) func() error {
+	return func() error {
+		_, err := All(ps...).Wait()
+		return err
+	}
+}
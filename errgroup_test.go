@@ -0,0 +1,46 @@
+package promises_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sync/errgroup"
+)
+
+func TestErrGroupSuite(t *testing.T) {
+	suite.Run(t, new(ErrGroupSuite))
+}
+
+type ErrGroupSuite struct {
+	suite.Suite
+}
+
+func (suite *ErrGroupSuite) TestErrGroupAdapter() {
+	var g errgroup.Group
+	g.Go(promises.ErrGroupAdapter(promises.Resolve(41), promises.Resolve(42)))
+	g.Go(promises.ErrGroupAdapter(promises.Resolve("hello")))
+	g.Go(func() error { return nil })
+	suite.Nil(g.Wait())
+}
+
+func (suite *ErrGroupSuite) TestErrGroupAdapter_rejected() {
+	tgtErr := errors.New("test error")
+	p, resolve, _ := promises.WithResolvers[int]()
+	defer resolve(0)
+
+	var g errgroup.Group
+	g.Go(promises.ErrGroupAdapter(promises.Resolve(41)))
+	g.Go(promises.ErrGroupAdapter(p, promises.Reject[int](tgtErr)))
+	suite.ErrorIs(g.Wait(), tgtErr)
+}
+
+func (suite *ErrGroupSuite) TestErrGroupAdapter_context() {
+	tgtErr := errors.New("test error")
+	g, ctx := errgroup.WithContext(context.Background())
+	g.Go(promises.ErrGroupAdapter(promises.Reject[int](tgtErr)))
+	g.Go(promises.ErrGroupAdapter(promises.Ctx[int](ctx)))
+	suite.ErrorIs(g.Wait(), tgtErr)
+}
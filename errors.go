@@ -1,26 +1,68 @@
 package promises
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"runtime/debug"
 	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 // ErrPanic returns from promise created by New or NewVoid when the generation
 // function panics.
 type ErrPanic struct {
 	Value any
+	// Stack is the stack trace of the panicked goroutine.
+	Stack []byte
+}
+
+// PanicFormatter renders the text of [ErrPanic] errors from the panic value and
+// the stack trace. It can be replaced to follow the logging conventions of the
+// application. If it is nil, the default "panic: <value>" format is used.
+var PanicFormatter func(value any, stack []byte) string = formatPanic
+
+func formatPanic(value any, _ []byte) string {
+	return fmt.Sprintf("panic: %v", value)
 }
 
 // Error returns the error text and makes ErrPanic compatible with the "error"
-// interface.
+// interface. The text is rendered by the [PanicFormatter].
 func (p *ErrPanic) Error() string {
-	return fmt.Sprintf("panic: %v", p.Value)
+	if PanicFormatter == nil {
+		return formatPanic(p.Value, p.Stack)
+	}
+	return PanicFormatter(p.Value, p.Stack)
 }
 
+// MaxPanicValueLen limits the size of the panic values kept in [ErrPanic]. If
+// it is positive and the string representation of the panic value is longer
+// than MaxPanicValueLen bytes, the Value field holds only the truncated string
+// representation instead of the original value. By default (zero) the original
+// value is kept. The truncation respects the UTF-8 rune boundaries, so the
+// truncated string can be a few bytes shorter than MaxPanicValueLen.
+var MaxPanicValueLen = 0
+
 func handlePanic(reject func(error)) {
 	if r := recover(); r != nil {
-		reject(&ErrPanic{r})
+		reject(&ErrPanic{Value: limitPanicValue(r), Stack: debug.Stack()})
+	}
+}
+
+func limitPanicValue(value any) any {
+	if MaxPanicValueLen <= 0 {
+		return value
+	}
+	if s := fmt.Sprint(value); len(s) > MaxPanicValueLen {
+		// Don't cut the multi-byte rune in half
+		n := MaxPanicValueLen
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+		return s[:n] + "..."
 	}
+	return value
 }
 
 // AggregateError returns from [Any] function when some promises are rejected.
@@ -31,6 +73,12 @@ type AggregateError struct {
 	Errors []error
 }
 
+// Unwrap returns the errors of the aggregate, so [errors.Is] and [errors.As]
+// can inspect them.
+func (e *AggregateError) Unwrap() []error {
+	return e.Errors
+}
+
 // Error returns the "\n"-join of all not-nil errors.
 func (e *AggregateError) Error() string {
 	var b strings.Builder
@@ -48,3 +96,95 @@ func (e *AggregateError) Error() string {
 	}
 	return b.String()
 }
+
+// Errors is a positional list of errors, where the nil entry means no error at
+// the corresponding position. It is the form of the [AggregateError] Errors
+// field.
+type Errors []error
+
+// NewErrors returns Errors of the n nil entries.
+func NewErrors(n int) Errors {
+	return make(Errors, n)
+}
+
+// Set sets the error at the position i.
+func (e Errors) Set(i int, err error) {
+	e[i] = err
+}
+
+// HasAny reports whether there is at least one not-nil error.
+func (e Errors) HasAny() bool {
+	for _, err := range e {
+		if err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrSchedulerClosed rejects the promises scheduled on the closed [Scheduler].
+var ErrSchedulerClosed = errors.New("scheduler is closed")
+
+// ErrLimiterClosed rejects the promises queued on the closed [RateLimiter].
+var ErrLimiterClosed = errors.New("rate limiter is closed")
+
+// ErrTimeout returns from [Promise.WaitFor] when the promise is not settled
+// within the given time. The actual error is a [TimeoutError], which matches
+// ErrTimeout with [errors.Is].
+var ErrTimeout = errors.New("promise timed out")
+
+// TimeoutError returns from [Promise.WaitFor] and [WithTimeout] when the promise
+// is not settled within the given time. It matches both [ErrTimeout] and
+// [context.DeadlineExceeded] with [errors.Is].
+type TimeoutError struct {
+	// Duration is the exceeded timeout.
+	Duration time.Duration
+}
+
+// Error returns the error text and makes TimeoutError compatible with the
+// "error" interface.
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("promise timed out after %v", e.Duration)
+}
+
+// Is reports whether the target is [ErrTimeout] or [context.DeadlineExceeded].
+func (e *TimeoutError) Is(target error) bool {
+	return target == ErrTimeout || target == context.DeadlineExceeded
+}
+
+// ErrNoMatch is an [AggregateError] entry for the promises that are fulfilled
+// with the value not matching the [FirstWhere] predicate.
+var ErrNoMatch = errors.New("value does not match")
+
+// ErrNoPromises returns from the aggregates that have no meaningful result for
+// the empty input, like [Min] and [Max].
+var ErrNoPromises = errors.New("no promises given")
+
+// ErrDuplicate is an [AggregateError] entry for the promises that are fulfilled
+// with the value already seen by [AnyDistinct].
+var ErrDuplicate = errors.New("duplicate value")
+
+// ErrShortRead returns from [ReadUntil] when the reader ends or fails before
+// the required number of bytes is read.
+type ErrShortRead struct {
+	// Data is the bytes read before the failure.
+	Data []byte
+	// Err is the read error, [io.ErrUnexpectedEOF] or [io.EOF] if the reader
+	// ended early.
+	Err error
+}
+
+// Error returns the error text and makes ErrShortRead compatible with the
+// "error" interface.
+func (e *ErrShortRead) Error() string {
+	return fmt.Sprintf("short read (%d bytes): %v", len(e.Data), e.Err)
+}
+
+// Unwrap returns the read error.
+func (e *ErrShortRead) Unwrap() error {
+	return e.Err
+}
+
+// ErrTypeAssertion returns from [FromSyncMap] when the stored value has an
+// unexpected type.
+var ErrTypeAssertion = errors.New("unexpected value type")
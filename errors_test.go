@@ -0,0 +1,114 @@
+package promises_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestErrorsSuite(t *testing.T) {
+	suite.Run(t, new(ErrorsSuite))
+}
+
+type ErrorsSuite struct {
+	suite.Suite
+}
+
+func (suite *ErrorsSuite) TestPanicFormatter() {
+	defer func(f func(any, []byte) string) { promises.PanicFormatter = f }(promises.PanicFormatter)
+	var stack []byte
+	promises.PanicFormatter = func(value any, s []byte) string {
+		stack = s
+		return fmt.Sprintf("recovered %q", value)
+	}
+
+	_, err := promises.New(func() (int, error) { panic("AAA!") }).Wait()
+	suite.EqualError(err, `recovered "AAA!"`)
+	suite.NotEmpty(stack, "formatter should receive the stack trace")
+}
+
+func (suite *ErrorsSuite) TestPanicFormatter_nil() {
+	defer func(f func(any, []byte) string) { promises.PanicFormatter = f }(promises.PanicFormatter)
+	promises.PanicFormatter = nil
+
+	_, err := promises.New(func() (int, error) { panic("AAA!") }).Wait()
+	suite.EqualError(err, "panic: AAA!")
+}
+
+func (suite *ErrorsSuite) TestMaxPanicValueLen() {
+	defer func(n int) { promises.MaxPanicValueLen = n }(promises.MaxPanicValueLen)
+	promises.MaxPanicValueLen = 10
+
+	big := make([]int, 1000)
+	_, err := promises.New(func() (int, error) { panic(big) }).Wait()
+	var panicErr *promises.ErrPanic
+	suite.Require().ErrorAs(err, &panicErr)
+	suite.Equal("[0 0 0 0 0...", panicErr.Value)
+
+	_, err = promises.New(func() (int, error) { panic("AAA!") }).Wait()
+	suite.Require().ErrorAs(err, &panicErr)
+	suite.Equal("AAA!", panicErr.Value, "short values should be kept as is")
+}
+
+func (suite *ErrorsSuite) TestMaxPanicValueLen_utf8() {
+	defer func(n int) { promises.MaxPanicValueLen = n }(promises.MaxPanicValueLen)
+	promises.MaxPanicValueLen = 5
+
+	// Each Cyrillic letter is 2 bytes, so the 5-byte cut falls inside the third one
+	_, err := promises.New(func() (int, error) { panic("Паника!") }).Wait()
+	var panicErr *promises.ErrPanic
+	suite.Require().ErrorAs(err, &panicErr)
+	suite.Equal("Па...", panicErr.Value)
+	suite.True(utf8.ValidString(err.Error()), "error text should be valid UTF-8")
+}
+
+func (suite *ErrorsSuite) TestMaxPanicValueLen_disabled() {
+	big := make([]int, 1000)
+	_, err := promises.New(func() (int, error) { panic(big) }).Wait()
+	var panicErr *promises.ErrPanic
+	suite.Require().ErrorAs(err, &panicErr)
+	suite.Equal(big, panicErr.Value)
+}
+
+func (suite *ErrorsSuite) TestTimeoutError() {
+	promise, _, _ := promises.WithResolvers[int]()
+	_, err := promises.WithTimeout(10*time.Millisecond, promise).Wait()
+
+	var timeoutErr *promises.TimeoutError
+	suite.Require().ErrorAs(err, &timeoutErr)
+	suite.Equal(10*time.Millisecond, timeoutErr.Duration)
+	suite.ErrorIs(err, promises.ErrTimeout)
+	suite.ErrorIs(err, context.DeadlineExceeded)
+	suite.EqualError(err, "promise timed out after 10ms")
+}
+
+func (suite *ErrorsSuite) TestTimeoutError_WaitFor() {
+	promise, _, _ := promises.WithResolvers[int]()
+	_, err := promise.WaitFor(10 * time.Millisecond)
+
+	var timeoutErr *promises.TimeoutError
+	suite.Require().ErrorAs(err, &timeoutErr)
+	suite.Equal(10*time.Millisecond, timeoutErr.Duration)
+	suite.ErrorIs(err, promises.ErrTimeout)
+}
+
+func (suite *ErrorsSuite) TestErrors() {
+	tgtErr := errors.New("test error")
+	errs := promises.NewErrors(3)
+	suite.Len(errs, 3)
+	suite.False(errs.HasAny())
+
+	errs.Set(1, tgtErr)
+	suite.True(errs.HasAny())
+	suite.Equal(promises.Errors{nil, tgtErr, nil}, errs)
+
+	aggErr := &promises.AggregateError{Errors: errs}
+	suite.EqualError(aggErr, "test error")
+	suite.ErrorIs(aggErr, tgtErr)
+}
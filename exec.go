@@ -0,0 +1,28 @@
+package promises
+
+import "os/exec"
+
+// FromCmd creates a promise that settles when the command completes. If the
+// command is not started yet, FromCmd starts it, captures its combined output
+// and resolves with the output bytes. The output is not captured, and the
+// promise resolves with nil, in two cases: if the command was already started
+// (FromCmd only waits for it), and if the caller has already set cmd.Stdout or
+// cmd.Stderr (the output goes there).
+//
+// The promise rejects with the run error, which is an [*exec.ExitError] if the
+// command exits with a non-zero status.
+//
+// Note that [WithContext] or [Race] do not stop the command when the promise
+// loses the race: the process keeps running in background. Create the command
+// with [exec.CommandContext] to kill it on the context cancellation.
+func FromCmd(cmd *exec.Cmd) Promise[[]byte] {
+	return New(func() ([]byte, error) {
+		if cmd.Process != nil {
+			return nil, cmd.Wait()
+		}
+		if cmd.Stdout != nil || cmd.Stderr != nil {
+			return nil, cmd.Run()
+		}
+		return cmd.CombinedOutput()
+	})
+}
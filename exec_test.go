@@ -0,0 +1,76 @@
+package promises_test
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestExecSuite(t *testing.T) {
+	suite.Run(t, new(ExecSuite))
+}
+
+type ExecSuite struct {
+	suite.Suite
+}
+
+func (suite *ExecSuite) TestFromCmd_true() {
+	val, err := promises.FromCmd(exec.Command("true")).Wait()
+	suite.Empty(val)
+	suite.Nil(err)
+}
+
+func (suite *ExecSuite) TestFromCmd_false() {
+	_, err := promises.FromCmd(exec.Command("false")).Wait()
+	var exitErr *exec.ExitError
+	suite.ErrorAs(err, &exitErr)
+	suite.Equal(1, exitErr.ExitCode())
+}
+
+func (suite *ExecSuite) TestFromCmd_output() {
+	val, err := promises.FromCmd(exec.Command("echo", "hello")).Wait()
+	suite.Equal("hello\n", string(val))
+	suite.Nil(err)
+}
+
+func (suite *ExecSuite) TestFromCmd_started() {
+	cmd := exec.Command("true")
+	suite.Require().NoError(cmd.Start())
+	val, err := promises.FromCmd(cmd).Wait()
+	suite.Nil(val)
+	suite.Nil(err)
+}
+
+func (suite *ExecSuite) TestFromCmd_preset_stdout() {
+	var stdout bytes.Buffer
+	cmd := exec.Command("echo", "hello")
+	cmd.Stdout = &stdout
+	val, err := promises.FromCmd(cmd).Wait()
+	suite.Nil(val)
+	suite.Nil(err)
+	suite.Equal("hello\n", stdout.String())
+}
+
+func (suite *ExecSuite) TestFromCmd_preset_stderr() {
+	var stderr bytes.Buffer
+	cmd := exec.Command("sh", "-c", "echo oops >&2; exit 2")
+	cmd.Stderr = &stderr
+	val, err := promises.FromCmd(cmd).Wait()
+	suite.Nil(val)
+	var exitErr *exec.ExitError
+	suite.Require().ErrorAs(err, &exitErr)
+	suite.Equal(2, exitErr.ExitCode())
+	suite.Equal("oops\n", stderr.String())
+}
+
+func (suite *ExecSuite) TestFromCmd_context() {
+	ctx, cancel := context.WithCancel(context.Background())
+	promise := promises.FromCmd(exec.CommandContext(ctx, "sleep", "10"))
+	cancel()
+	_, err := promise.Wait()
+	suite.Error(err, "command should be killed")
+}
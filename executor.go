@@ -0,0 +1,36 @@
+package promises
+
+// Executor runs functions, e.g. on a specific goroutine (like a UI loop) or on
+// a worker pool.
+type Executor interface {
+	Run(func())
+}
+
+// ExecutorFunc is an adapter to allow the use of ordinary functions as
+// executors.
+type ExecutorFunc func(func())
+
+// Run calls f(fn).
+func (f ExecutorFunc) Run(fn func()) { f(fn) }
+
+// GoExecutor is the default executor that runs each function in a new
+// goroutine.
+var GoExecutor Executor = ExecutorFunc(func(fn func()) { go fn() })
+
+// ThenOn acts like [Then], but the gen function is dispatched to the given
+// executor instead of being called in a new goroutine. A nil exec means
+// [GoExecutor]. If the executor panics instead of running gen, the returned
+// promise rejects with [ErrPanic].
+func ThenOn[T, P any](exec Executor, p Promise[T], gen func(T) (P, error)) Promise[P] {
+	if exec == nil {
+		exec = GoExecutor
+	}
+	result, resolve, reject := WithResolvers[P]()
+	Link(p, func(v T) {
+		defer handlePanic(reject)
+		exec.Run(func() {
+			runGen(func() (P, error) { return gen(v) }, resolve, reject)
+		})
+	}, reject)
+	return result
+}
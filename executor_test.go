@@ -0,0 +1,99 @@
+package promises_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestExecutorSuite(t *testing.T) {
+	suite.Run(t, new(ExecutorSuite))
+}
+
+type ExecutorSuite struct {
+	suite.Suite
+}
+
+// serialExecutor runs all functions one by one on a single goroutine.
+type serialExecutor struct {
+	fns chan func()
+}
+
+func newSerialExecutor() *serialExecutor {
+	e := &serialExecutor{fns: make(chan func())}
+	go func() {
+		for fn := range e.fns {
+			fn()
+		}
+	}()
+	return e
+}
+
+func (e *serialExecutor) Run(fn func()) { e.fns <- fn }
+
+func (e *serialExecutor) Close() { close(e.fns) }
+
+func (suite *ExecutorSuite) TestThenOn() {
+	exec := newSerialExecutor()
+	defer exec.Close()
+
+	// onExecutor is only accessed from the executor goroutine
+	onExecutor := false
+	exec.Run(func() { onExecutor = true })
+
+	promise := promises.ThenOn(exec, promises.Resolve(42), func(v int) (string, error) {
+		if !onExecutor {
+			return "", errors.New("not on executor")
+		}
+		return strconv.Itoa(v), nil
+	})
+	val, err := promise.Wait()
+	suite.Equal("42", val)
+	suite.Nil(err)
+}
+
+func (suite *ExecutorSuite) TestThenOn_rejected() {
+	tgtErr := errors.New("test error")
+	runs := 0
+	exec := promises.ExecutorFunc(func(fn func()) { runs++; fn() })
+
+	promise := promises.ThenOn(exec, promises.Reject[int](tgtErr), func(v int) (int, error) {
+		return v, nil
+	})
+	_, err := promise.Wait()
+	suite.Equal(tgtErr, err)
+	suite.Zero(runs, "executor should not be used for rejections")
+}
+
+func (suite *ExecutorSuite) TestThenOn_nil_executor() {
+	promise := promises.ThenOn(nil, promises.Resolve(41), func(v int) (int, error) {
+		return v + 1, nil
+	})
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *ExecutorSuite) TestThenOn_executor_panic() {
+	exec := promises.ExecutorFunc(func(func()) { panic("executor is closed") })
+
+	promise := promises.ThenOn(exec, promises.Resolve(42), func(v int) (int, error) {
+		return v, nil
+	})
+	_, err := promise.Wait()
+	var panicErr *promises.ErrPanic
+	suite.Require().ErrorAs(err, &panicErr)
+	suite.Equal("executor is closed", panicErr.Value)
+}
+
+func (suite *ExecutorSuite) TestThenOn_GoExecutor() {
+	promise := promises.ThenOn(promises.GoExecutor, promises.Resolve(41), func(v int) (int, error) {
+		return v + 1, nil
+	})
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
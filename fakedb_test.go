@@ -0,0 +1,70 @@
+package promises_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync/atomic"
+)
+
+// fakeDriver is a minimal database/sql driver for tests. The "fail" query
+// returns errFakeQuery, any other query returns the rows 1, 2, 3. The numbers
+// of the committed and rolled back transactions are counted in fakeCommits and
+// fakeRollbacks, the number of the closed rows is counted in fakeRowsClosed.
+type fakeDriver struct{}
+
+var errFakeQuery = errors.New("fake query error")
+
+var fakeCommits, fakeRollbacks, fakeRowsClosed atomic.Int32
+
+func init() {
+	sql.Register("fakedb", fakeDriver{})
+}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{query}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return fakeTx{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { fakeCommits.Add(1); return nil }
+func (fakeTx) Rollback() error { fakeRollbacks.Add(1); return nil }
+
+type fakeStmt struct {
+	query string
+}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+
+func (fakeStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+
+func (s fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	if s.query == "fail" {
+		return nil, errFakeQuery
+	}
+	return &fakeRows{values: []int64{1, 2, 3}}, nil
+}
+
+type fakeRows struct {
+	values []int64
+}
+
+func (*fakeRows) Columns() []string { return []string{"n"} }
+func (*fakeRows) Close() error      { fakeRowsClosed.Add(1); return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0] = r.values[0]
+	r.values = r.values[1:]
+	return nil
+}
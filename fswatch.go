@@ -0,0 +1,90 @@
+package promises
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"time"
+)
+
+// FSOp describes the kind of filesystem change.
+type FSOp int
+
+// Filesystem changes reported by [FromFSWatch].
+const (
+	FSCreate FSOp = iota + 1
+	FSWrite
+	FSRemove
+)
+
+// String returns the name of the operation.
+func (op FSOp) String() string {
+	switch op {
+	case FSCreate:
+		return "CREATE"
+	case FSWrite:
+		return "WRITE"
+	case FSRemove:
+		return "REMOVE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// FSEvent is a filesystem change reported by [FromFSWatch].
+type FSEvent struct {
+	Path string
+	Op   FSOp
+}
+
+// FSWatchInterval is the polling interval of [FromFSWatch].
+var FSWatchInterval = 100 * time.Millisecond
+
+// FromFSWatch creates a promise that resolves with the first filesystem event
+// on the given path: its creation, removal, or change of its size, mode or
+// modification time. It rejects if the path cannot be inspected.
+//
+// FromFSWatch polls the path with the [FSWatchInterval] and doesn't require any
+// OS-specific notification API. The polling is stopped when the promise
+// settles, so it keeps going until the first event. Note that [WithContext] or
+// [Race] do not stop it.
+func FromFSWatch(path string) Promise[FSEvent] {
+	return New(func() (FSEvent, error) {
+		prev, err := statIfExists(path)
+		if err != nil {
+			return FSEvent{}, err
+		}
+
+		ticker := time.NewTicker(FSWatchInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			cur, err := statIfExists(path)
+			if err != nil {
+				return FSEvent{}, err
+			}
+			switch {
+			case prev == nil && cur != nil:
+				return FSEvent{path, FSCreate}, nil
+			case prev != nil && cur == nil:
+				return FSEvent{path, FSRemove}, nil
+			case prev != nil && cur != nil && (prev.Size() != cur.Size() ||
+				prev.Mode() != cur.Mode() ||
+				!prev.ModTime().Equal(cur.ModTime())):
+				return FSEvent{path, FSWrite}, nil
+			}
+		}
+
+		// We should never reach this
+		return FSEvent{}, nil
+	})
+}
+
+// statIfExists returns nil info and nil error if the path doesn't exist.
+func statIfExists(path string) (fs.FileInfo, error) {
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	return info, err
+}
@@ -0,0 +1,55 @@
+package promises_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestFSWatchSuite(t *testing.T) {
+	suite.Run(t, new(FSWatchSuite))
+}
+
+type FSWatchSuite struct {
+	suite.Suite
+}
+
+func (suite *FSWatchSuite) TestFromFSWatch_create() {
+	path := filepath.Join(suite.T().TempDir(), "file.txt")
+	promise := promises.FromFSWatch(path)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	suite.Require().NoError(os.WriteFile(path, []byte("hello"), 0o644))
+	val, err := promise.Wait()
+	suite.Equal(promises.FSEvent{Path: path, Op: promises.FSCreate}, val)
+	suite.Nil(err)
+}
+
+func (suite *FSWatchSuite) TestFromFSWatch_write() {
+	path := filepath.Join(suite.T().TempDir(), "file.txt")
+	suite.Require().NoError(os.WriteFile(path, []byte("hello"), 0o644))
+
+	promise := promises.FromFSWatch(path)
+	time.Sleep(10 * time.Millisecond)
+	suite.Require().NoError(os.WriteFile(path, []byte("hello, world"), 0o644))
+	val, err := promise.Wait()
+	suite.Equal(promises.FSEvent{Path: path, Op: promises.FSWrite}, val)
+	suite.Nil(err)
+}
+
+func (suite *FSWatchSuite) TestFromFSWatch_remove() {
+	path := filepath.Join(suite.T().TempDir(), "file.txt")
+	suite.Require().NoError(os.WriteFile(path, []byte("hello"), 0o644))
+
+	promise := promises.FromFSWatch(path)
+	time.Sleep(10 * time.Millisecond)
+	suite.Require().NoError(os.Remove(path))
+	val, err := promise.Wait()
+	suite.Equal(promises.FSEvent{Path: path, Op: promises.FSRemove}, val)
+	suite.Nil(err)
+}
@@ -2,7 +2,11 @@ module github.com/davidmz/go-promises
 
 go 1.21.1
 
-require github.com/stretchr/testify v1.9.0
+require (
+	github.com/stretchr/testify v1.9.0
+	golang.org/x/sync v0.6.0
+	golang.org/x/time v0.5.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
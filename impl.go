@@ -1,36 +1,178 @@
 package promises
 
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// impl is the implementation of Promise. For the regular promises, the value
+// and err fields are written only once, before the done channel is closed. Per
+// the Go memory model, the close of a channel happens before a receive that
+// returns because the channel is closed, so any number of goroutines that read
+// the fields after receiving from done observe the same settled values without
+// additional locking.
+//
+// The pooled promises (see [SettledResolve]) are the exception: they share the
+// already closed done channel, and their fields are written after it is
+// closed, each time the promise is taken from the pool. It is still race-free,
+// because the fields are written before the promise is returned to the caller,
+// so any goroutine that can read them receives the promise (and so the written
+// values) via some synchronization made by the caller. [Release] resets the
+// fields only when the promise is no longer referenced.
 type impl[T any] struct {
 	value T
 	err   error
 	done  chan struct{}
+	// pooled is true for the already settled promises taken from the pool (see
+	// [SettledResolve] and [SettledReject]).
+	pooled bool
+	// tracked is true for the promises that are tracked by the leak tracker and
+	// not awaited yet.
+	tracked atomic.Bool
+
+	// listenersMu guards listeners and dispatching.
+	listenersMu sync.Mutex
+	// listeners are the callbacks registered by [OnSettle] and not called yet.
+	listeners []func(T, error)
+	// dispatching is true while the goroutine calling listeners is running.
+	dispatching bool
+}
+
+func newImpl[T any]() *impl[T] {
+	p := &impl[T]{done: make(chan struct{})}
+	if leakTracking.Load() {
+		p.tracked.Store(true)
+		pendingPromises.Add(1)
+	}
+	return p
+}
+
+// markAwaited removes the promise from the leak tracker.
+func (p *impl[T]) markAwaited() {
+	if p.tracked.Load() && p.tracked.CompareAndSwap(true, false) {
+		pendingPromises.Add(-1)
+	}
 }
 
 func (p *impl[T]) Wait() (T, error) {
 	<-p.done
+	p.markAwaited()
 	return p.value, p.err
 }
 
-func (p *impl[T]) Done() <-chan struct{} {
-	return p.done
+func (p *impl[T]) WaitOk() (T, bool) {
+	<-p.done
+	p.markAwaited()
+	return p.value, p.err == nil
 }
 
-func (p *impl[T]) resolve(value T) {
+func (p *impl[T]) WaitFor(d time.Duration) (T, error) {
 	select {
 	case <-p.done:
-		break
+		p.markAwaited()
+		return p.value, p.err
 	default:
-		p.value = value
-		close(p.done)
+	}
+
+	timer := currentClock().NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-p.done:
+		p.markAwaited()
+		return p.value, p.err
+	case <-timer.C():
+		return zero[T](), &TimeoutError{d}
+	}
+}
+
+func (p *impl[T]) WaitOrDefault(d time.Duration, fallback T) T {
+	v, err := p.WaitFor(d)
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+func (p *impl[T]) WaitOrSignal(sig <-chan struct{}) (T, error, bool) {
+	select {
+	case <-p.done:
+	default:
+		select {
+		case <-p.done:
+		case <-sig:
+			return zero[T](), nil, false
+		}
+	}
+	p.markAwaited()
+	return p.value, p.err, true
+}
+
+func (p *impl[T]) Done() <-chan struct{} {
+	return p.done
+}
+
+// onSettle adds cb to the listeners of the promise. The listeners are called
+// one by one, in the order they are added, by a single goroutine that is
+// started on demand.
+func (p *impl[T]) onSettle(cb func(T, error)) {
+	p.listenersMu.Lock()
+	defer p.listenersMu.Unlock()
+	p.listeners = append(p.listeners, cb)
+	if !p.dispatching {
+		p.dispatching = true
+		go p.dispatchListeners()
 	}
 }
 
+func (p *impl[T]) dispatchListeners() {
+	v, err := p.Wait()
+	for {
+		p.listenersMu.Lock()
+		cbs := p.listeners
+		p.listeners = nil
+		if len(cbs) == 0 {
+			p.dispatching = false
+			p.listenersMu.Unlock()
+			return
+		}
+		p.listenersMu.Unlock()
+
+		for _, cb := range cbs {
+			callListener(cb, v, err)
+		}
+	}
+}
+
+// callListener calls cb and logs its panic, if any, so that the panicking
+// callback doesn't break the others.
+func callListener[T any](cb func(T, error), v T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logf("promises: OnSettle callback panicked: %v", r)
+		}
+	}()
+	cb(v, err)
+}
+
+func (p *impl[T]) resolve(value T) {
+	p.settle(value, nil)
+}
+
 func (p *impl[T]) reject(err error) {
+	p.settle(zero[T](), err)
+}
+
+// settle sets both the value and the error of the promise. It allows to reject
+// the promise keeping some (partial) value.
+func (p *impl[T]) settle(value T, err error) {
 	select {
 	case <-p.done:
-		break
+		if warnDoubleSettle.Load() {
+			logf("promises: ignoring settle of already settled promise (value: %v, error: %v)", value, err)
+		}
 	default:
-		p.err = err
+		p.value, p.err = value, err
 		close(p.done)
 	}
 }
@@ -0,0 +1,62 @@
+package promises
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// FromStream creates a promise that calls recv once in a separate goroutine
+// (e.g. the Recv method of a streaming client), and resolves with the received
+// message or rejects with the returned error. Note that the end of the stream
+// is a rejection too: if recv returns [io.EOF], the promise rejects with it.
+func FromStream[T any](recv func() (T, error)) Promise[T] {
+	return New(recv)
+}
+
+// DecodeJSON creates a promise that decodes the JSON value from r into T in a
+// separate goroutine (e.g. the body of an HTTP response). It resolves with the
+// decoded value or rejects with the decode error. If r is an [io.Closer], it
+// is closed after decoding.
+func DecodeJSON[T any](r io.Reader) Promise[T] {
+	return decodeJSON[T](r, false)
+}
+
+// DecodeJSONStrict acts like [DecodeJSON], but rejects if the input contains
+// object keys that do not match any field of T (see
+// [json.Decoder.DisallowUnknownFields]).
+func DecodeJSONStrict[T any](r io.Reader) Promise[T] {
+	return decodeJSON[T](r, true)
+}
+
+func decodeJSON[T any](r io.Reader, strict bool) Promise[T] {
+	return New(func() (T, error) {
+		if c, ok := r.(io.Closer); ok {
+			defer c.Close()
+		}
+		dec := json.NewDecoder(r)
+		if strict {
+			dec.DisallowUnknownFields()
+		}
+		var v T
+		if err := dec.Decode(&v); err != nil {
+			return zero[T](), err
+		}
+		return v, nil
+	})
+}
+
+// ReadUntil creates a promise that reads exactly n bytes from r in a separate
+// goroutine (e.g. a fixed-size protocol header), and resolves with them. If
+// the reader fails or ends before n bytes are read, the promise rejects with
+// [ErrShortRead] holding the bytes read so far. Use [WithTimeout] to bound the
+// wait.
+func ReadUntil(r io.Reader, n int) Promise[[]byte] {
+	return New(func() ([]byte, error) {
+		buf := make([]byte, max(n, 0))
+		read, err := io.ReadFull(r, buf)
+		if err != nil {
+			return nil, &ErrShortRead{buf[:read], err}
+		}
+		return buf, nil
+	})
+}
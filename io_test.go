@@ -0,0 +1,131 @@
+package promises_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestIOSuite(t *testing.T) {
+	suite.Run(t, new(IOSuite))
+}
+
+type IOSuite struct {
+	suite.Suite
+}
+
+func (suite *IOSuite) TestFromStream() {
+	calls := 0
+	promise := promises.FromStream(func() (string, error) {
+		calls++
+		return "hello", nil
+	})
+	val, err := promise.Wait()
+	suite.Equal("hello", val)
+	suite.Nil(err)
+	suite.Equal(1, calls, "recv should be called once")
+}
+
+func (suite *IOSuite) TestFromStream_EOF() {
+	promise := promises.FromStream(func() (string, error) { return "", io.EOF })
+	val, err := promise.Wait()
+	suite.Empty(val)
+	suite.ErrorIs(err, io.EOF)
+}
+
+type jsonPoint struct {
+	X, Y int
+}
+
+type closeTracker struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeTracker) Close() error {
+	c.closed = true
+	return nil
+}
+
+func (suite *IOSuite) TestDecodeJSON() {
+	r := &closeTracker{Reader: strings.NewReader(`{"X": 1, "Y": 2}`)}
+	val, err := promises.DecodeJSON[jsonPoint](r).Wait()
+	suite.Equal(jsonPoint{1, 2}, val)
+	suite.Nil(err)
+	suite.True(r.closed, "reader should be closed")
+}
+
+func (suite *IOSuite) TestDecodeJSON_malformed() {
+	r := &closeTracker{Reader: strings.NewReader(`{"X": 1,`)}
+	val, err := promises.DecodeJSON[jsonPoint](r).Wait()
+	suite.Empty(val)
+	suite.Error(err)
+	suite.True(r.closed, "reader should be closed")
+}
+
+func (suite *IOSuite) TestDecodeJSON_unknownFields() {
+	val, err := promises.DecodeJSON[jsonPoint](strings.NewReader(`{"X": 1, "Z": 3}`)).Wait()
+	suite.Equal(jsonPoint{X: 1}, val)
+	suite.Nil(err)
+}
+
+func (suite *IOSuite) TestDecodeJSONStrict() {
+	val, err := promises.DecodeJSONStrict[jsonPoint](strings.NewReader(`{"X": 1, "Y": 2}`)).Wait()
+	suite.Equal(jsonPoint{1, 2}, val)
+	suite.Nil(err)
+}
+
+func (suite *IOSuite) TestDecodeJSONStrict_unknownFields() {
+	val, err := promises.DecodeJSONStrict[jsonPoint](strings.NewReader(`{"X": 1, "Z": 3}`)).Wait()
+	suite.Empty(val)
+	suite.ErrorContains(err, "unknown field")
+}
+
+func (suite *IOSuite) TestDecodeJSON_syntaxError() {
+	_, err := promises.DecodeJSON[jsonPoint](strings.NewReader(`nope`)).Wait()
+	var syntaxErr *json.SyntaxError
+	suite.ErrorAs(err, &syntaxErr)
+}
+
+func (suite *IOSuite) TestReadUntil() {
+	r := strings.NewReader("headerbody")
+	val, err := promises.ReadUntil(r, 6).Wait()
+	suite.Equal([]byte("header"), val)
+	suite.Nil(err)
+
+	rest, _ := io.ReadAll(r)
+	suite.Equal("body", string(rest), "only n bytes should be read")
+}
+
+func (suite *IOSuite) TestReadUntil_short() {
+	val, err := promises.ReadUntil(strings.NewReader("head"), 6).Wait()
+	suite.Nil(val)
+	var shortErr *promises.ErrShortRead
+	suite.Require().ErrorAs(err, &shortErr)
+	suite.Equal([]byte("head"), shortErr.Data)
+	suite.ErrorIs(err, io.ErrUnexpectedEOF)
+}
+
+func (suite *IOSuite) TestReadUntil_empty() {
+	_, err := promises.ReadUntil(strings.NewReader(""), 6).Wait()
+	var shortErr *promises.ErrShortRead
+	suite.Require().ErrorAs(err, &shortErr)
+	suite.Empty(shortErr.Data)
+	suite.ErrorIs(err, io.EOF)
+}
+
+func (suite *IOSuite) TestReadUntil_error() {
+	tgtErr := errors.New("test error")
+	r := io.MultiReader(strings.NewReader("he"), iotest.ErrReader(tgtErr))
+	_, err := promises.ReadUntil(r, 6).Wait()
+	var shortErr *promises.ErrShortRead
+	suite.Require().ErrorAs(err, &shortErr)
+	suite.Equal([]byte("he"), shortErr.Data)
+	suite.ErrorIs(err, tgtErr)
+}
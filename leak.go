@@ -0,0 +1,45 @@
+package promises
+
+import "sync/atomic"
+
+var (
+	leakTracking    atomic.Bool
+	pendingPromises atomic.Int64
+)
+
+// EnableLeakTracking enables tracking of the promises that are created but never
+// awaited (by Wait, WaitOk, WaitOrSignal or successful WaitFor and
+// WaitOrDefault). The tracking applies to the promises created after this call
+// and can not be disabled. The promises the package creates for its internal
+// needs are not tracked. It is cheap, but it is still off by default.
+func EnableLeakTracking() {
+	leakTracking.Store(true)
+}
+
+// PendingPromises returns the number of tracked promises that are not awaited
+// yet. See [EnableLeakTracking].
+func PendingPromises() int {
+	return int(pendingPromises.Load())
+}
+
+// untracked excludes the promise created by the package for its internal needs
+// from the leak tracking, so that it is not reported as leaked when nobody
+// waits for it.
+func untracked[T any](p Promise[T]) Promise[T] {
+	if p, ok := p.(*impl[T]); ok {
+		p.markAwaited()
+	}
+	return p
+}
+
+// Fire marks the promise as created for its side effect only (fire-and-forget):
+// it waits for the promise to settle in a background goroutine, so the promise
+// is not reported as leaked (see [EnableLeakTracking]). If the promise rejects,
+// the rejection is logged via [Log] instead of being silently lost.
+func Fire[T any](p Promise[T]) {
+	go func() {
+		if _, err := p.Wait(); err != nil {
+			logf("promises: fired promise rejected: %v", err)
+		}
+	}()
+}
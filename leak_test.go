@@ -0,0 +1,113 @@
+package promises_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestLeakSuite(t *testing.T) {
+	suite.Run(t, new(LeakSuite))
+}
+
+type LeakSuite struct {
+	suite.Suite
+}
+
+func (suite *LeakSuite) SetupSuite() {
+	promises.EnableLeakTracking()
+}
+
+func (suite *LeakSuite) TestPendingPromises() {
+	before := promises.PendingPromises()
+
+	p1 := promises.Resolve(41)
+	p2 := promises.Resolve(42)
+	p3, resolve3, _ := promises.WithResolvers[int]()
+	suite.Equal(before+3, promises.PendingPromises())
+
+	_, _ = p1.Wait()
+	_, _ = p1.Wait()
+	suite.Equal(before+2, promises.PendingPromises(), "second Wait should not count")
+
+	_, _ = p2.WaitOk()
+	suite.Equal(before+1, promises.PendingPromises())
+
+	_, _ = p3.WaitFor(0)
+	suite.Equal(before+1, promises.PendingPromises(), "timed out WaitFor should not count")
+
+	resolve3(43)
+	_, _ = p3.WaitFor(0)
+	suite.Equal(before, promises.PendingPromises())
+}
+
+func (suite *LeakSuite) TestPendingPromises_WithContext() {
+	before := promises.PendingPromises()
+
+	v, err := promises.WithContext(context.Background(), promises.Resolve(1)).Wait()
+	suite.Equal(1, v)
+	suite.NoError(err)
+	suite.Equal(before, promises.PendingPromises(), "internal promises should not be tracked")
+}
+
+func (suite *LeakSuite) TestPendingPromises_FirstSuccess() {
+	before := promises.PendingPromises()
+
+	release := make(chan struct{})
+	defer close(release)
+	v, err := promises.FirstSuccess(
+		func() (int, error) { return 1, nil },
+		func() (int, error) { <-release; return 2, nil },
+	).Wait()
+	suite.Equal(1, v)
+	suite.NoError(err)
+	suite.Equal(before, promises.PendingPromises(), "losing promises should not be tracked")
+}
+
+type recordingLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *recordingLogger) Printf(format string, v ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func (l *recordingLogger) Lines() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.lines...)
+}
+
+func (suite *LeakSuite) TestFire() {
+	before := promises.PendingPromises()
+
+	promise, resolve, _ := promises.WithResolvers[int]()
+	suite.Equal(before+1, promises.PendingPromises())
+
+	promises.Fire(promise)
+	resolve(42)
+	suite.Eventually(func() bool {
+		return promises.PendingPromises() == before
+	}, time.Second, time.Millisecond, "fired promise should be awaited")
+}
+
+func (suite *LeakSuite) TestFire_rejected() {
+	logger := new(recordingLogger)
+	promises.Log = logger
+	defer func() { promises.Log = nil }()
+
+	promises.Fire(promises.Reject[int](errors.New("test error")))
+	suite.Eventually(func() bool {
+		return len(logger.Lines()) == 1
+	}, time.Second, time.Millisecond)
+	suite.Contains(logger.Lines()[0], "test error")
+}
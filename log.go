@@ -0,0 +1,30 @@
+package promises
+
+import "sync/atomic"
+
+// Logger is the interface of the package logger. The standard [log.Logger]
+// satisfies it.
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+// Log is the logger for the events that can not be reported otherwise, like
+// the rejections of the fired promises (see [Fire]). It is nil by default,
+// which disables logging. Set it once, before using the package.
+var Log Logger
+
+func logf(format string, v ...any) {
+	if Log != nil {
+		Log.Printf(format, v...)
+	}
+}
+
+var warnDoubleSettle atomic.Bool
+
+// WarnOnDoubleSettle enables logging (via [Log]) of the attempts to resolve or
+// reject the already settled promises. Such attempts are silently ignored by
+// default, and they often indicate the logic bugs. The warning includes the
+// ignored value and error. The mode can not be disabled.
+func WarnOnDoubleSettle() {
+	warnDoubleSettle.Store(true)
+}
@@ -0,0 +1,45 @@
+package promises_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestLogSuite(t *testing.T) {
+	suite.Run(t, new(LogSuite))
+}
+
+type LogSuite struct {
+	suite.Suite
+	logger *recordingLogger
+}
+
+func (suite *LogSuite) SetupTest() {
+	suite.logger = new(recordingLogger)
+	promises.Log = suite.logger
+}
+
+func (suite *LogSuite) TearDownTest() {
+	promises.Log = nil
+}
+
+func (suite *LogSuite) TestWarnOnDoubleSettle() {
+	promise, resolve, reject := promises.WithResolvers[int]()
+	resolve(41)
+	suite.Empty(suite.logger.Lines(), "first settle should not be logged")
+
+	promises.WarnOnDoubleSettle()
+	resolve(42)
+	reject(errors.New("test error"))
+	suite.Equal([]string{
+		"promises: ignoring settle of already settled promise (value: 42, error: <nil>)",
+		"promises: ignoring settle of already settled promise (value: 0, error: test error)",
+	}, suite.logger.Lines())
+
+	val, err := promise.Wait()
+	suite.Equal(41, val, "promise value should not change")
+	suite.Nil(err)
+}
@@ -0,0 +1,143 @@
+package promises
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// MapGroup calls fn for each of the items concurrently, with at most limit
+// calls running at the same time (no limit if limit is not positive), and
+// returns a promise that fulfills with the array of results in the order of
+// items.
+//
+// On the first fn error the context passed to fn is canceled, so the running
+// calls can abort, the items that are not started yet are skipped, and the
+// returned promise rejects with this error. It also rejects with ctx.Err() if
+// the parent context is done before all items are processed.
+func MapGroup[T, R any](
+	ctx context.Context,
+	items []T,
+	limit int,
+	fn func(context.Context, T) (R, error),
+) Promise[[]R] {
+	if limit <= 0 {
+		limit = len(items)
+	}
+	return New(func() ([]R, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make([]R, len(items))
+		sem := make(chan struct{}, limit)
+		wg := new(sync.WaitGroup)
+		once := new(sync.Once)
+		var firstErr error
+		fail := func(err error) {
+			once.Do(func() {
+				firstErr = err
+				cancel()
+			})
+		}
+
+	loop:
+		for i, item := range items {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				break loop
+			}
+			if ctx.Err() != nil {
+				break
+			}
+			wg.Add(1)
+			go func(i int, item T) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				defer handlePanic(fail)
+				r, err := fn(ctx, item)
+				if err != nil {
+					fail(err)
+				} else {
+					results[i] = r
+				}
+			}(i, item)
+		}
+		wg.Wait()
+
+		if firstErr != nil {
+			return nil, firstErr
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return results, nil
+	})
+}
+
+// MapReduce calls mapper for each of the items concurrently, on at most
+// GOMAXPROCS worker goroutines, and folds the mapped values with the reducer,
+// starting from the initial value. The reducer is called sequentially in the
+// order of mappers completion, not in the order of items, so it should be
+// associative and commutative if the order matters.
+//
+// The returned promise fulfills with the folded value, or rejects with the
+// first mapper or reducer error; after the error the mappers that are not
+// started yet are skipped.
+func MapReduce[T, M, R any](
+	items []T,
+	mapper func(T) (M, error),
+	reducer func(R, M) (R, error),
+	initial R,
+) Promise[R] {
+	return New(func() (R, error) {
+		workers := min(runtime.GOMAXPROCS(0), len(items))
+		inputs := make(chan T)
+		results := make(chan Result[M])
+		stop := make(chan struct{})
+		defer close(stop)
+
+		go func() {
+			defer close(inputs)
+			for _, item := range items {
+				select {
+				case inputs <- item:
+				case <-stop:
+					return
+				}
+			}
+		}()
+
+		wg := new(sync.WaitGroup)
+		wg.Add(workers)
+		for i := 0; i < workers; i++ {
+			go func() {
+				defer wg.Done()
+				for item := range inputs {
+					r := WaitResult(NewSync(func() (M, error) { return mapper(item) }))
+					select {
+					case results <- r:
+					case <-stop:
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		acc := initial
+		for r := range results {
+			if r.Err != nil {
+				return zero[R](), r.Err
+			}
+			var err error
+			if acc, err = reducer(acc, r.Value); err != nil {
+				return zero[R](), err
+			}
+		}
+		return acc, nil
+	})
+}
@@ -0,0 +1,179 @@
+package promises_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMapSuite(t *testing.T) {
+	suite.Run(t, new(MapSuite))
+}
+
+type MapSuite struct {
+	suite.Suite
+}
+
+func (suite *MapSuite) TestMapGroup() {
+	promise := promises.MapGroup(
+		context.Background(),
+		[]int{1, 2, 3, 4},
+		2,
+		func(_ context.Context, v int) (int, error) { return v * v, nil },
+	)
+	val, err := promise.Wait()
+	suite.Equal([]int{1, 4, 9, 16}, val)
+	suite.Nil(err)
+}
+
+func (suite *MapSuite) TestMapGroup_first_error() {
+	tgtErr := errors.New("test error")
+	var mu sync.Mutex
+	var started []int
+
+	promise := promises.MapGroup(
+		context.Background(),
+		[]int{1, 2, 3, 4, 5},
+		1,
+		func(_ context.Context, v int) (int, error) {
+			mu.Lock()
+			started = append(started, v)
+			mu.Unlock()
+			if v == 2 {
+				return 0, tgtErr
+			}
+			return v, nil
+		},
+	)
+	val, err := promise.Wait()
+	suite.Nil(val)
+	suite.Equal(tgtErr, err)
+	suite.Equal([]int{1, 2}, started, "unstarted items should not run after the first error")
+}
+
+func (suite *MapSuite) TestMapGroup_cancel_in_flight() {
+	tgtErr := errors.New("test error")
+	promise := promises.MapGroup(
+		context.Background(),
+		[]int{1, 2},
+		0,
+		func(ctx context.Context, v int) (int, error) {
+			if v == 1 {
+				return 0, tgtErr
+			}
+			<-ctx.Done()
+			return 0, ctx.Err()
+		},
+	)
+	_, err := promise.Wait()
+	suite.Equal(tgtErr, err)
+}
+
+func (suite *MapSuite) TestMapGroup_parent_canceled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	promise := promises.MapGroup(
+		ctx,
+		[]int{1, 2},
+		1,
+		func(_ context.Context, v int) (int, error) { return v, nil },
+	)
+	_, err := promise.Wait()
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *MapSuite) TestMapReduce() {
+	promise := promises.MapReduce(
+		[]int{1, 2, 3, 4},
+		func(v int) (int, error) { return v * v, nil },
+		func(acc int, v int) (int, error) { return acc + v, nil },
+		0,
+	)
+	val, err := promise.Wait()
+	suite.Equal(30, val)
+	suite.Nil(err)
+}
+
+func (suite *MapSuite) TestMapReduce_mapper_error() {
+	tgtErr := errors.New("test error")
+	promise := promises.MapReduce(
+		[]int{1, 2, 3},
+		func(v int) (int, error) {
+			if v == 2 {
+				return 0, tgtErr
+			}
+			return v, nil
+		},
+		func(acc int, v int) (int, error) { return acc + v, nil },
+		0,
+	)
+	val, err := promise.Wait()
+	suite.Zero(val)
+	suite.Equal(tgtErr, err)
+}
+
+func (suite *MapSuite) TestMapReduce_reducer_error() {
+	tgtErr := errors.New("test error")
+	promise := promises.MapReduce(
+		[]int{1, 2, 3},
+		func(v int) (int, error) { return v, nil },
+		func(acc int, v int) (int, error) { return 0, tgtErr },
+		0,
+	)
+	_, err := promise.Wait()
+	suite.Equal(tgtErr, err)
+}
+
+func (suite *MapSuite) TestMapReduce_bounded_goroutines() {
+	const items = 10000
+	before := runtime.NumGoroutine()
+	var maxGoroutines atomic.Int64
+	promise := promises.MapReduce(
+		make([]int, items),
+		func(v int) (int, error) {
+			n := int64(runtime.NumGoroutine())
+			for {
+				m := maxGoroutines.Load()
+				if n <= m || maxGoroutines.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			return 1, nil
+		},
+		func(acc int, v int) (int, error) { return acc + v, nil },
+		0,
+	)
+	val, err := promise.Wait()
+	suite.Equal(items, val)
+	suite.Nil(err)
+	suite.Less(int(maxGoroutines.Load())-before, runtime.GOMAXPROCS(0)+50,
+		"goroutines should be bounded by the number of workers")
+}
+
+func (suite *MapSuite) TestMapReduce_panic() {
+	_, err := promises.MapReduce(
+		[]int{1, 2, 3},
+		func(v int) (int, error) { panic("AAA!") },
+		func(acc int, v int) (int, error) { return acc + v, nil },
+		0,
+	).Wait()
+	var panicErr *promises.ErrPanic
+	suite.ErrorAs(err, &panicErr)
+}
+
+func (suite *MapSuite) TestMapReduce_empty() {
+	val, err := promises.MapReduce(
+		nil,
+		func(v int) (int, error) { return v, nil },
+		func(acc int, v int) (int, error) { return acc + v, nil },
+		42,
+	).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
@@ -0,0 +1,39 @@
+package promises
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// MetricsSink receives the timing metrics of the instrumented steps, see
+// [ThenTimed]. err is the step error (nil on success).
+type MetricsSink func(name string, d time.Duration, err error)
+
+var metricsSink atomic.Pointer[MetricsSink]
+
+// SetMetricsSink sets the sink for the timing metrics. The nil sink (the
+// default) disables recording. It is safe to call concurrently with the
+// instrumented steps.
+func SetMetricsSink(sink MetricsSink) {
+	if sink == nil {
+		metricsSink.Store(nil)
+		return
+	}
+	metricsSink.Store(&sink)
+}
+
+// ThenTimed acts like [Then], but measures the duration of the gen call and
+// reports it to the metrics sink (see [SetMetricsSink]) under the given name,
+// along with the gen error. If gen panics, the [ErrPanic] is reported. The
+// rejections of p are passed through and not reported, as gen is not called.
+func ThenTimed[T, P any](p Promise[T], name string, gen func(T) (P, error)) Promise[P] {
+	return Then(p, func(v T) (P, error) {
+		clk := currentClock()
+		start := clk.Now()
+		result, err := NewSync(func() (P, error) { return gen(v) }).Wait()
+		if sink := metricsSink.Load(); sink != nil {
+			(*sink)(name, clk.Now().Sub(start), err)
+		}
+		return result, err
+	})
+}
@@ -0,0 +1,97 @@
+package promises_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestMetricsSuite(t *testing.T) {
+	suite.Run(t, new(MetricsSuite))
+}
+
+type MetricsSuite struct {
+	suite.Suite
+	mu      sync.Mutex
+	records []metricRecord
+}
+
+type metricRecord struct {
+	name string
+	d    time.Duration
+	err  error
+}
+
+func (suite *MetricsSuite) SetupTest() {
+	suite.records = nil
+	promises.SetMetricsSink(func(name string, d time.Duration, err error) {
+		suite.mu.Lock()
+		defer suite.mu.Unlock()
+		suite.records = append(suite.records, metricRecord{name, d, err})
+	})
+}
+
+func (suite *MetricsSuite) TearDownTest() {
+	promises.SetMetricsSink(nil)
+}
+
+func (suite *MetricsSuite) TestThenTimed() {
+	promise := promises.ThenTimed(promises.Resolve(21), "double", func(v int) (int, error) {
+		time.Sleep(10 * time.Millisecond)
+		return v * 2, nil
+	})
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+
+	suite.Require().Len(suite.records, 1)
+	suite.Equal("double", suite.records[0].name)
+	suite.GreaterOrEqual(suite.records[0].d, 10*time.Millisecond)
+	suite.Nil(suite.records[0].err)
+}
+
+func (suite *MetricsSuite) TestThenTimed_error() {
+	tgtErr := errors.New("test error")
+	_, err := promises.ThenTimed(promises.Resolve(21), "fail", func(v int) (int, error) {
+		return 0, tgtErr
+	}).Wait()
+	suite.ErrorIs(err, tgtErr)
+
+	suite.Require().Len(suite.records, 1)
+	suite.Equal("fail", suite.records[0].name)
+	suite.ErrorIs(suite.records[0].err, tgtErr)
+}
+
+func (suite *MetricsSuite) TestThenTimed_panic() {
+	_, err := promises.ThenTimed(promises.Resolve(21), "panic", func(v int) (int, error) {
+		panic("AAA!")
+	}).Wait()
+	var panicErr *promises.ErrPanic
+	suite.ErrorAs(err, &panicErr)
+
+	suite.Require().Len(suite.records, 1)
+	suite.ErrorAs(suite.records[0].err, &panicErr)
+}
+
+func (suite *MetricsSuite) TestThenTimed_source_rejected() {
+	tgtErr := errors.New("test error")
+	_, err := promises.ThenTimed(promises.Reject[int](tgtErr), "skipped", func(v int) (int, error) {
+		return v, nil
+	}).Wait()
+	suite.ErrorIs(err, tgtErr)
+	suite.Empty(suite.records, "rejected source should not be recorded")
+}
+
+func (suite *MetricsSuite) TestThenTimed_no_sink() {
+	promises.SetMetricsSink(nil)
+	val, err := promises.ThenTimed(promises.Resolve(21), "double", func(v int) (int, error) {
+		return v * 2, nil
+	}).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+	suite.Empty(suite.records)
+}
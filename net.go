@@ -0,0 +1,20 @@
+package promises
+
+import (
+	"context"
+	"net"
+)
+
+// Dial creates a promise that connects to the address on the named network
+// (see [net.Dialer.DialContext]), and resolves with the connection or rejects
+// with the dial error. If the context is done before the connection is
+// established, the dial is aborted and the promise rejects with the error
+// reflecting the context.
+//
+// The caller owns the connection of the fulfilled promise and must close it.
+func Dial(ctx context.Context, network, address string) Promise[net.Conn] {
+	return New(func() (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, network, address)
+	})
+}
@@ -0,0 +1,39 @@
+package promises_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestNetSuite(t *testing.T) {
+	suite.Run(t, new(NetSuite))
+}
+
+type NetSuite struct {
+	suite.Suite
+}
+
+func (suite *NetSuite) TestDial() {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+	defer ln.Close()
+
+	conn, err := promises.Dial(context.Background(), "tcp", ln.Addr().String()).Wait()
+	suite.Require().NoError(err)
+	conn.Close()
+}
+
+func (suite *NetSuite) TestDial_unreachable() {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// 192.0.2.0/24 is reserved for documentation (RFC 5737)
+	conn, err := promises.Dial(ctx, "tcp", "192.0.2.1:80").Wait()
+	suite.Nil(conn)
+	suite.Error(err)
+}
@@ -0,0 +1,58 @@
+package promises
+
+import (
+	"reflect"
+	"sync"
+)
+
+// closedChan is a shared done channel of all pooled promises.
+var closedChan = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+// settledPools holds a *sync.Pool of settled *impl[T] for each T.
+var settledPools sync.Map
+
+func settledPool[T any]() *sync.Pool {
+	key := reflect.TypeOf((*T)(nil))
+	if pool, ok := settledPools.Load(key); ok {
+		return pool.(*sync.Pool)
+	}
+	pool, _ := settledPools.LoadOrStore(key, &sync.Pool{
+		New: func() any { return &impl[T]{done: closedChan, pooled: true} },
+	})
+	return pool.(*sync.Pool)
+}
+
+// SettledResolve acts like [Resolve], but reuses the promise objects returned
+// to the pool by [Release]. It reduces allocations in the hot paths that create
+// a lot of short-lived already resolved promises.
+func SettledResolve[T any](value T) Promise[T] {
+	p := settledPool[T]().Get().(*impl[T])
+	p.value = value
+	return p
+}
+
+// SettledReject acts like [Reject], but reuses the promise objects returned to
+// the pool by [Release]. See [SettledResolve].
+func SettledReject[T any](err error) Promise[T] {
+	p := settledPool[T]().Get().(*impl[T])
+	p.err = err
+	return p
+}
+
+// Release returns the promise created by [SettledResolve] or [SettledReject] to
+// the pool. It does nothing for any other promises.
+//
+// Release must be called only when the promise is fully consumed and no longer
+// referenced. After the release, the promise object can be reused by another
+// SettledResolve or SettledReject call, so any use of the released promise
+// (including the second Release) may return someone else's result.
+func Release[T any](p Promise[T]) {
+	if p, ok := p.(*impl[T]); ok && p.pooled {
+		p.value, p.err = zero[T](), nil
+		settledPool[T]().Put(p)
+	}
+}
@@ -0,0 +1,83 @@
+package promises_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestPoolSuite(t *testing.T) {
+	suite.Run(t, new(PoolSuite))
+}
+
+type PoolSuite struct {
+	suite.Suite
+}
+
+func (suite *PoolSuite) TestSettledResolve() {
+	promise := promises.SettledResolve(42)
+	suite.True(isSettled(promise), "promise should be settled")
+	val, err := promise.Wait()
+	suite.Equal(42, val, "promise should resolve with correct value")
+	suite.Nil(err, "error should be nil")
+	promises.Release(promise)
+}
+
+func (suite *PoolSuite) TestSettledReject() {
+	tgtErr := errors.New("some error")
+	promise := promises.SettledReject[int](tgtErr)
+	suite.True(isSettled(promise), "promise should be settled")
+	val, err := promise.Wait()
+	suite.Equal(0, val, "promise value should be zero")
+	suite.Equal(tgtErr, err, "error should have the passed value")
+	promises.Release(promise)
+}
+
+func (suite *PoolSuite) TestReuse() {
+	tgtErr := errors.New("some error")
+	for i := 0; i < 100; i++ {
+		p1 := promises.SettledResolve(i)
+		val, err := p1.Wait()
+		suite.Equal(i, val)
+		suite.Nil(err)
+		promises.Release(p1)
+
+		p2 := promises.SettledReject[int](tgtErr)
+		val, err = p2.Wait()
+		suite.Zero(val, "released value should not leak to the reused promise")
+		suite.Equal(tgtErr, err)
+		promises.Release(p2)
+
+		p3 := promises.SettledResolve(i)
+		_, err = p3.Wait()
+		suite.Nil(err, "released error should not leak to the reused promise")
+		promises.Release(p3)
+	}
+}
+
+func (suite *PoolSuite) TestRelease_not_pooled() {
+	promise := promises.Resolve(42)
+	promises.Release(promise)
+	val, err := promise.Wait()
+	suite.Equal(42, val, "release should not affect not pooled promises")
+	suite.Nil(err)
+}
+
+func BenchmarkResolve(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		p := promises.Resolve(i)
+		_, _ = p.Wait()
+	}
+}
+
+func BenchmarkSettledResolve(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		p := promises.SettledResolve(i)
+		_, _ = p.Wait()
+		promises.Release(p)
+	}
+}
@@ -3,13 +3,37 @@
 // sometimes and in the some cases it can be handy.
 package promises
 
+import "time"
+
 // Promise is a basic promise interface.
 type Promise[T any] interface {
 	// Wait waits for promise to settle and returns it value or error. If
-	// promise is already settled, it returns immediately.
+	// promise is already settled, it returns immediately. It is safe to call
+	// Wait from many goroutines concurrently, all of them receive the same
+	// result.
 	Wait() (T, error)
+	// WaitOk waits for promise to settle and returns it value and the flag that
+	// is true if the promise is fulfilled and false if it is rejected.
+	WaitOk() (T, bool)
+	// WaitFor acts like Wait, but waits no longer than d. If the promise is not
+	// settled within d, it returns a [TimeoutError].
+	WaitFor(d time.Duration) (T, error)
+	// WaitOrDefault acts like WaitFor, but never fails: it returns the
+	// fulfillment value if the promise fulfills within d, and fallback if it
+	// rejects or is not settled within d.
+	WaitOrDefault(d time.Duration, fallback T) T
+	// WaitOrSignal acts like Wait, but returns early if sig fires (receives a
+	// value or is closed) before the promise is settled. The last result is
+	// true if the promise is settled, and false (with the zero value and nil
+	// error) if the signal fired first. If both are ready, the settled result
+	// is preferred.
+	WaitOrSignal(sig <-chan struct{}) (T, error, bool)
 	// Done returns a channel that is closed when the promise is settled. It is
 	// useful for waiting promise with some other channels with "select".
+	//
+	// Done always returns the same channel, which is closed only once and stays
+	// closed after settlement, so it is safe to read it any number of times and
+	// from any number of concurrent consumers.
 	Done() <-chan struct{}
 }
 
@@ -21,7 +45,7 @@ func WithResolvers[T any]() (
 	resolve func(T),
 	reject func(error),
 ) {
-	p := &impl[T]{done: make(chan struct{})}
+	p := newImpl[T]()
 	return p, p.resolve, p.reject
 }
 
@@ -48,18 +72,79 @@ func New[T any](gen func() (T, error)) Promise[T] {
 		resolve(*new(T))
 		return p
 	}
-	go func() {
-		defer handlePanic(reject)
-		value, err := gen()
-		if err != nil {
-			reject(err)
-		} else {
-			resolve(value)
-		}
-	}()
+	go runGen(gen, resolve, reject)
+	return p
+}
+
+// New1 acts like [New], but passes arg to gen. It allows to reuse the same
+// function for many promises without allocating a capturing closure for each
+// of them, which matters when spawning promises in a hot loop.
+func New1[T, A any](gen func(A) (T, error), arg A) Promise[T] {
+	p := newImpl[T]()
+	if gen == nil {
+		p.resolve(zero[T]())
+		return p
+	}
+	go runGen1(p, gen, arg)
 	return p
 }
 
+// New2 acts like [New1], but passes two arguments to gen.
+func New2[T, A, B any](gen func(A, B) (T, error), arg1 A, arg2 B) Promise[T] {
+	p := newImpl[T]()
+	if gen == nil {
+		p.resolve(zero[T]())
+		return p
+	}
+	go runGen2(p, gen, arg1, arg2)
+	return p
+}
+
+func runGen1[T, A any](p *impl[T], gen func(A) (T, error), arg A) {
+	defer handlePanic(p.reject)
+	value, err := gen(arg)
+	if err != nil {
+		p.reject(err)
+	} else {
+		p.resolve(value)
+	}
+}
+
+func runGen2[T, A, B any](p *impl[T], gen func(A, B) (T, error), arg1 A, arg2 B) {
+	defer handlePanic(p.reject)
+	value, err := gen(arg1, arg2)
+	if err != nil {
+		p.reject(err)
+	} else {
+		p.resolve(value)
+	}
+}
+
+// NewSync acts like [New], but calls the provided function synchronously in the
+// calling goroutine, so the returned promise is already settled. It is useful
+// for deterministic tests.
+func NewSync[T any](gen func() (T, error)) Promise[T] {
+	p, resolve, reject := WithResolvers[T]()
+	if gen == nil {
+		resolve(*new(T))
+		return p
+	}
+	runGen(gen, resolve, reject)
+	return p
+}
+
+// runGen calls gen and settles the promise with its result. If gen panics, the
+// promise is rejected with [ErrPanic].
+func runGen[T any](gen func() (T, error), resolve func(T), reject func(error)) {
+	defer handlePanic(reject)
+	value, err := gen()
+	if err != nil {
+		reject(err)
+	} else {
+		resolve(value)
+	}
+}
+
 // NewVoid acting same as [New], but takes a function that returns only an error.
 // It creates a promise with empty (struct{}) result.
 func NewVoid(gen func() error) Promise[struct{}] {
@@ -73,13 +158,47 @@ func NewVoid(gen func() error) Promise[struct{}] {
 // Then is an utility function that waits for the given promise and, if it
 // fulfilled, processes the result using the gen function.
 func Then[T, P any](p Promise[T], gen func(T) (P, error)) Promise[P] {
-	return New((func() (P, error) {
-		v, err := p.Wait()
+	result, resolve, reject := WithResolvers[P]()
+	Link(p, func(v T) {
+		runGen(func() (P, error) { return gen(v) }, resolve, reject)
+	}, reject)
+	return result
+}
+
+// Link waits for the src promise in a background goroutine and, when it
+// settles, calls dst with the fulfillment value or dstErr with the rejection
+// reason. Exactly one of the callbacks is called, once; the nil callbacks are
+// skipped. Link returns immediately. It is a low-level primitive for wiring
+// promises into callback-based code.
+func Link[T any](src Promise[T], dst func(T), dstErr func(error)) {
+	go func() {
+		v, err := src.Wait()
 		if err != nil {
-			return zero[P](), err
+			if dstErr != nil {
+				dstErr(err)
+			}
+		} else if dst != nil {
+			dst(v)
 		}
-		return gen(v)
-	}))
+	}()
+}
+
+// OnSettle registers cb to be called with the outcome of p when it settles.
+// Unlike [Then], it doesn't produce a new promise: the callbacks are pure
+// side-effect observers. Any number of callbacks can be registered on the same
+// promise, and all of them are called in the order registered, one by one, in
+// a background goroutine. So a slow callback delays the next ones of the same
+// promise. The panic in a callback is logged via [Log] and doesn't prevent the
+// next callbacks from being called.
+func OnSettle[T any](p Promise[T], cb func(T, error)) {
+	if p, ok := p.(*impl[T]); ok {
+		p.onSettle(cb)
+		return
+	}
+	go func() {
+		v, err := p.Wait()
+		callListener(cb, v, err)
+	}()
 }
 
 func zero[T any]() T { return *new(T) }
@@ -2,6 +2,9 @@ package promises_test
 
 import (
 	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,6 +16,7 @@ func TestPromisesSuites(t *testing.T) {
 	suite.Run(t, new(WithResolversSuite))
 	suite.Run(t, new(ResolveRejectSuite))
 	suite.Run(t, new(NewPromiseSuite))
+	suite.Run(t, new(LinkSuite))
 }
 
 type WithResolversSuite struct {
@@ -72,6 +76,153 @@ func (suite *WithResolversSuite) TestRejectSecondCall() {
 	suite.Equal(err, err1, "error should have the passed value")
 }
 
+func (suite *WithResolversSuite) TestDone_repeated() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	suite.Equal(promise.Done(), promise.Done(), "Done should return the same channel")
+	resolve(42)
+	for i := 0; i < 3; i++ {
+		suite.True(isSettled(promise), "promise should stay settled")
+	}
+	suite.Equal(promise.Done(), promise.Done(), "Done should return the same channel")
+}
+
+func (suite *WithResolversSuite) TestDone_concurrent() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	const consumers = 100
+
+	wg := new(sync.WaitGroup)
+	wg.Add(consumers)
+	for i := 0; i < consumers; i++ {
+		go func() {
+			defer wg.Done()
+			<-promise.Done()
+			<-promise.Done()
+		}()
+	}
+
+	resolve(42)
+	wg.Wait()
+	suite.True(isSettled(promise), "promise should be settled")
+}
+
+func (suite *WithResolversSuite) TestWaitOk_resolved() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	resolve(42)
+	val, ok := promise.WaitOk()
+	suite.Equal(42, val, "promise should resolve with correct value")
+	suite.True(ok, "ok should be true")
+}
+
+func (suite *WithResolversSuite) TestWaitOk_rejected() {
+	promise, _, reject := promises.WithResolvers[int]()
+	reject(errors.New("some error"))
+	val, ok := promise.WaitOk()
+	suite.Equal(0, val, "promise value should be zero")
+	suite.False(ok, "ok should be false")
+}
+
+func (suite *WithResolversSuite) TestWaitFor_settled() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		resolve(42)
+	}()
+	val, err := promise.WaitFor(time.Second)
+	suite.Equal(42, val, "promise should resolve with correct value")
+	suite.Nil(err, "error should be nil")
+}
+
+func (suite *WithResolversSuite) TestWaitFor_timeout() {
+	promise, _, _ := promises.WithResolvers[int]()
+	val, err := promise.WaitFor(10 * time.Millisecond)
+	suite.Equal(0, val, "promise value should be zero")
+	suite.ErrorIs(err, promises.ErrTimeout)
+	suite.False(isSettled(promise), "promise should not be settled")
+}
+
+func (suite *WithResolversSuite) TestWaitOrDefault_settled() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		resolve(42)
+	}()
+	suite.Equal(42, promise.WaitOrDefault(time.Second, -1))
+}
+
+func (suite *WithResolversSuite) TestWaitOrDefault_timeout() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	suite.Equal(-1, promise.WaitOrDefault(10*time.Millisecond, -1))
+
+	resolve(42)
+	suite.Equal(42, promise.WaitOrDefault(10*time.Millisecond, -1))
+}
+
+func (suite *WithResolversSuite) TestWaitOrDefault_rejected() {
+	promise := promises.Reject[int](errors.New("some error"))
+	suite.Equal(-1, promise.WaitOrDefault(time.Second, -1))
+}
+
+func (suite *WithResolversSuite) TestWaitOrSignal_settled() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		resolve(42)
+	}()
+	val, err, ok := promise.WaitOrSignal(make(chan struct{}))
+	suite.Equal(42, val)
+	suite.Nil(err)
+	suite.True(ok, "promise should be settled")
+}
+
+func (suite *WithResolversSuite) TestWaitOrSignal_signal() {
+	promise, _, _ := promises.WithResolvers[int]()
+	sig := make(chan struct{})
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(sig)
+	}()
+	val, err, ok := promise.WaitOrSignal(sig)
+	suite.Equal(0, val)
+	suite.Nil(err)
+	suite.False(ok, "signal should fire first")
+	suite.False(isSettled(promise), "promise should not be settled")
+}
+
+func (suite *WithResolversSuite) TestWaitOrSignal_both_ready() {
+	tgtErr := errors.New("some error")
+	sig := make(chan struct{})
+	close(sig)
+	val, err, ok := promises.Reject[int](tgtErr).WaitOrSignal(sig)
+	suite.Equal(0, val)
+	suite.Equal(tgtErr, err)
+	suite.True(ok, "settled result should be preferred")
+}
+
+func (suite *WithResolversSuite) TestWait_many_waiters() {
+	promise, resolve, _ := promises.WithResolvers[[]int]()
+	const waiters = 1000
+
+	results := make([][]int, waiters)
+	errs := make([]error, waiters)
+	wg := new(sync.WaitGroup)
+	wg.Add(waiters)
+	for i := 0; i < waiters; i++ {
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = promise.Wait()
+		}(i)
+	}
+
+	value := []int{41, 42, 43}
+	resolve(value)
+	wg.Wait()
+
+	for i := 0; i < waiters; i++ {
+		suite.Equal(value, results[i], "all waiters should receive the same value")
+		suite.Nil(errs[i], "error should be nil")
+	}
+}
+
 type ResolveRejectSuite struct {
 	suite.Suite
 }
@@ -124,3 +275,181 @@ func (suite *NewPromiseSuite) TestNewPanic() {
 	suite.Equal(0, val, "promise value should be zero")
 	suite.ErrorContains(err, "panic: AAA!")
 }
+
+func (suite *NewPromiseSuite) TestNewSync_resolve() {
+	promise := promises.NewSync(func() (int, error) { return 42, nil })
+	suite.True(isSettled(promise), "promise should be settled")
+	val, err := promise.Wait()
+	suite.Equal(42, val, "promise should resolve with correct value")
+	suite.Nil(err, "error should be nil")
+}
+
+func (suite *NewPromiseSuite) TestNewSync_reject() {
+	firedErr := errors.New("some error")
+	promise := promises.NewSync(func() (int, error) { return 42, firedErr })
+	suite.True(isSettled(promise), "promise should be settled")
+	val, err := promise.Wait()
+	suite.Equal(0, val, "promise value should be zero")
+	suite.Equal(firedErr, err, "error should have the passed value")
+}
+
+func (suite *NewPromiseSuite) TestNewSync_panic() {
+	promise := promises.NewSync(func() (int, error) { panic("AAA!") })
+	suite.True(isSettled(promise), "promise should be settled")
+	val, err := promise.Wait()
+	suite.Equal(0, val, "promise value should be zero")
+	suite.ErrorContains(err, "panic: AAA!")
+}
+
+func (suite *NewPromiseSuite) TestNew1() {
+	promise := promises.New1(func(n int) (int, error) { return n * 2, nil }, 21)
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *NewPromiseSuite) TestNew1_reject() {
+	firedErr := errors.New("some error")
+	promise := promises.New1(func(s string) (int, error) { return 0, fmt.Errorf("%s: %w", s, firedErr) }, "arg")
+	val, err := promise.Wait()
+	suite.Equal(0, val)
+	suite.ErrorIs(err, firedErr)
+	suite.ErrorContains(err, "arg: some error")
+}
+
+func (suite *NewPromiseSuite) TestNew1_panic() {
+	promise := promises.New1(func(int) (int, error) { panic("AAA!") }, 1)
+	val, err := promise.Wait()
+	suite.Equal(0, val, "promise value should be zero")
+	suite.ErrorContains(err, "panic: AAA!")
+}
+
+func (suite *NewPromiseSuite) TestNew2() {
+	promise := promises.New2(func(s string, n int) (string, error) { return strings.Repeat(s, n), nil }, "ab", 3)
+	val, err := promise.Wait()
+	suite.Equal("ababab", val)
+	suite.Nil(err)
+}
+
+func double(n int) (int, error) { return n * 2, nil }
+
+func BenchmarkNew_closure(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		n := i
+		_, _ = promises.New(func() (int, error) { return double(n) }).Wait()
+	}
+}
+
+func BenchmarkNew1(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = promises.New1(double, i).Wait()
+	}
+}
+
+type LinkSuite struct {
+	suite.Suite
+}
+
+func (suite *LinkSuite) TestLink_resolved() {
+	values := make(chan int, 1)
+	promises.Link(
+		promises.Resolve(42),
+		func(v int) { values <- v },
+		func(err error) { suite.Fail("dstErr should not be called") },
+	)
+	suite.Equal(42, <-values)
+}
+
+func (suite *LinkSuite) TestLink_rejected() {
+	tgtErr := errors.New("some error")
+	errs := make(chan error, 1)
+	promises.Link(
+		promises.Reject[int](tgtErr),
+		func(v int) { suite.Fail("dst should not be called") },
+		func(err error) { errs <- err },
+	)
+	suite.Equal(tgtErr, <-errs)
+}
+
+func (suite *LinkSuite) TestLink_nil_callbacks() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	values := make(chan int, 1)
+	// Link signals via dst, so the nil dstErr is already skipped when it's received
+	promises.Link(promise, func(v int) { values <- v }, nil)
+	resolve(42)
+	suite.Equal(42, <-values)
+
+	// Both callbacks are nil: Link must not panic
+	promises.Link(promise, nil, nil)
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *LinkSuite) TestLink_nil_callbacks_rejected() {
+	tgtErr := errors.New("some error")
+	promise, _, reject := promises.WithResolvers[int]()
+	errs := make(chan error, 1)
+	// Link signals via dstErr, so the nil dst is already skipped when it's received
+	promises.Link(promise, nil, func(err error) { errs <- err })
+	reject(tgtErr)
+	suite.Equal(tgtErr, <-errs)
+
+	// Both callbacks are nil: Link must not panic
+	promises.Link(promise, nil, nil)
+	_, err := promise.Wait()
+	suite.Equal(tgtErr, err)
+}
+
+func (suite *LinkSuite) TestOnSettle() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	values := make(chan int, 2)
+	promises.OnSettle(promise, func(v int, err error) {
+		suite.Nil(err)
+		values <- v
+	})
+	promises.OnSettle(promise, func(v int, err error) {
+		suite.Nil(err)
+		values <- v * 2
+	})
+	resolve(21)
+	suite.ElementsMatch([]int{21, 42}, []int{<-values, <-values})
+}
+
+func (suite *LinkSuite) TestOnSettle_order() {
+	promise, resolve, _ := promises.WithResolvers[int]()
+	const n = 100
+	order := make(chan int, n)
+	for i := 0; i < n; i++ {
+		i := i
+		promises.OnSettle(promise, func(int, error) { order <- i })
+	}
+	resolve(42)
+	for i := 0; i < n; i++ {
+		suite.Equal(i, <-order)
+	}
+
+	// The callbacks registered after the promise settles are called too
+	promises.OnSettle(promise, func(v int, err error) { order <- v })
+	suite.Equal(42, <-order)
+}
+
+func (suite *LinkSuite) TestOnSettle_panic() {
+	promise := promises.Resolve(42)
+	values := make(chan int, 1)
+	promises.OnSettle(promise, func(int, error) { panic("callback panic") })
+	promises.OnSettle(promise, func(v int, err error) { values <- v })
+	suite.Equal(42, <-values, "callback after the panicking one should be called")
+}
+
+func (suite *LinkSuite) TestOnSettle_rejected() {
+	tgtErr := errors.New("some error")
+	errs := make(chan error, 1)
+	promises.OnSettle(promises.Reject[int](tgtErr), func(v int, err error) {
+		suite.Zero(v)
+		errs <- err
+	})
+	suite.Equal(tgtErr, <-errs)
+}
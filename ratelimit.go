@@ -0,0 +1,116 @@
+package promises
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimiter starts the functions passed to its Do method at the limited rate:
+// at most perInterval functions are started within any interval. The excess
+// functions are queued and started in order as the rate allows.
+type RateLimiter[T any] struct {
+	interval time.Duration
+	tokens   chan struct{}
+	closing  chan struct{}
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  []*limitedTask[T]
+	closed bool
+}
+
+type limitedTask[T any] struct {
+	fn      func() (T, error)
+	resolve func(T)
+	reject  func(error)
+}
+
+// RateLimit creates a new [RateLimiter] that allows at most perInterval (at
+// least one) function starts within any interval.
+func RateLimit[T any](perInterval int, interval time.Duration) *RateLimiter[T] {
+	perInterval = max(perInterval, 1)
+	l := &RateLimiter[T]{
+		interval: interval,
+		tokens:   make(chan struct{}, perInterval),
+		closing:  make(chan struct{}),
+	}
+	l.cond = sync.NewCond(&l.mu)
+	for i := 0; i < perInterval; i++ {
+		l.tokens <- struct{}{}
+	}
+	go l.dispatch()
+	return l
+}
+
+// Do queues fn to start when the rate allows, and returns a promise that
+// settles with the fn result. If the limiter is closed, the promise is rejected
+// with [ErrLimiterClosed].
+func (l *RateLimiter[T]) Do(fn func() (T, error)) Promise[T] {
+	p, resolve, reject := WithResolvers[T]()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		reject(ErrLimiterClosed)
+		return p
+	}
+	l.queue = append(l.queue, &limitedTask[T]{fn, resolve, reject})
+	l.cond.Signal()
+	return p
+}
+
+// Close closes the limiter. The queued functions that are not started yet are
+// not called, and their promises are rejected with [ErrLimiterClosed].
+func (l *RateLimiter[T]) Close() {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return
+	}
+	l.closed = true
+	queue := l.queue
+	l.queue = nil
+	close(l.closing)
+	l.mu.Unlock()
+
+	l.cond.Broadcast()
+	for _, t := range queue {
+		t.reject(ErrLimiterClosed)
+	}
+}
+
+func (l *RateLimiter[T]) dispatch() {
+	for {
+		l.mu.Lock()
+		for len(l.queue) == 0 && !l.closed {
+			l.cond.Wait()
+		}
+		if l.closed {
+			l.mu.Unlock()
+			return
+		}
+		t := l.queue[0]
+		l.queue[0] = nil
+		l.queue = l.queue[1:]
+		l.mu.Unlock()
+
+		select {
+		case <-l.tokens:
+		case <-l.closing:
+			t.reject(ErrLimiterClosed)
+			return
+		}
+		time.AfterFunc(l.interval, func() { l.tokens <- struct{}{} })
+		go runGen(t.fn, t.resolve, t.reject)
+	}
+}
+
+// WaitLimiter creates a promise that resolves when the limiter allows one
+// event (see [rate.Limiter.Wait]), or rejects with the limiter error, e.g. the
+// context error on cancellation. It allows the rate-limited operations to be
+// chained with [Then].
+func WaitLimiter(ctx context.Context, lim *rate.Limiter) Promise[struct{}] {
+	return NewVoid(func() error { return lim.Wait(ctx) })
+}
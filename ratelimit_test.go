@@ -0,0 +1,94 @@
+package promises_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimitSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitSuite))
+}
+
+type RateLimitSuite struct {
+	suite.Suite
+}
+
+func (suite *RateLimitSuite) TestDo_rate() {
+	const interval = 50 * time.Millisecond
+	l := promises.RateLimit[time.Time](2, interval)
+	defer l.Close()
+
+	ps := make([]promises.Promise[time.Time], 5)
+	for i := range ps {
+		ps[i] = l.Do(func() (time.Time, error) { return time.Now(), nil })
+	}
+	starts, err := promises.All(ps...).Wait()
+	suite.Require().Nil(err)
+
+	for i := 2; i < len(starts); i++ {
+		suite.GreaterOrEqual(
+			starts[i].Sub(starts[i-2]), interval-5*time.Millisecond,
+			"no more than 2 starts should be within the interval",
+		)
+	}
+}
+
+func (suite *RateLimitSuite) TestClose() {
+	l := promises.RateLimit[int](1, time.Hour)
+
+	var mu sync.Mutex
+	calls := 0
+	fn := func() (int, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return 42, nil
+	}
+
+	val, err := l.Do(fn).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+
+	queued := l.Do(fn)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(queued), "promise should be queued")
+
+	l.Close()
+	_, err = queued.Wait()
+	suite.ErrorIs(err, promises.ErrLimiterClosed)
+
+	_, err = l.Do(fn).Wait()
+	suite.ErrorIs(err, promises.ErrLimiterClosed)
+	suite.Equal(1, calls)
+}
+
+func (suite *RateLimitSuite) TestWaitLimiter() {
+	const interval = 50 * time.Millisecond
+	lim := rate.NewLimiter(rate.Every(interval), 1)
+
+	start := time.Now()
+	_, err := promises.WaitLimiter(context.Background(), lim).Wait()
+	suite.Nil(err)
+	suite.Less(time.Since(start), interval, "first token should be available immediately")
+
+	_, err = promises.WaitLimiter(context.Background(), lim).Wait()
+	suite.Nil(err)
+	suite.GreaterOrEqual(time.Since(start), interval-5*time.Millisecond, "second token should wait for the interval")
+}
+
+func (suite *RateLimitSuite) TestWaitLimiter_canceled() {
+	lim := rate.NewLimiter(rate.Every(time.Hour), 1)
+	suite.True(lim.Allow())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	promise := promises.WaitLimiter(ctx, lim)
+	cancel()
+	_, err := promise.Wait()
+	suite.Error(err)
+}
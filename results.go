@@ -0,0 +1,116 @@
+package promises
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+)
+
+// Results is a list of [Result] values, in the same form as returned by
+// [AllSettled].
+type Results[T any] []Result[T]
+
+// Values returns the values of all fulfilled results, in order. Values of the
+// rejected results are skipped.
+func (rs Results[T]) Values() []T {
+	values := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.Err == nil {
+			values = append(values, r.Value)
+		}
+	}
+	return values
+}
+
+// Err returns the join (see [errors.Join]) of all not-nil errors, or nil if
+// all results are fulfilled.
+func (rs Results[T]) Err() error {
+	var errs []error
+	for _, r := range rs {
+		if r.Err != nil {
+			errs = append(errs, r.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// MergeResults concatenates several Results into one, preserving their order.
+// Nil or empty arguments are skipped. It is useful for combining the outputs of
+// several [AllSettled] batches before the final Err check.
+func MergeResults[T any](rs ...Results[T]) Results[T] {
+	size := 0
+	for _, r := range rs {
+		size += len(r)
+	}
+	if size == 0 {
+		return nil
+	}
+	merged := make(Results[T], 0, size)
+	for _, r := range rs {
+		merged = append(merged, r...)
+	}
+	return merged
+}
+
+// WaitResult waits for the promise to settle and returns its outcome as a
+// single [Result] value.
+func WaitResult[T any](p Promise[T]) Result[T] {
+	v, err := p.Wait()
+	return Result[T]{v, err}
+}
+
+// Reflect returns a promise that never rejects: it fulfills with the [Result]
+// describing the outcome of the given promise. The reflected promises can be
+// combined with [All] to get the outcome of each of them.
+func Reflect[T any](p Promise[T]) Promise[Result[T]] {
+	return New(func() (Result[T], error) { return WaitResult(p), nil })
+}
+
+// PeekResult returns the outcome of the promise and true if the promise is
+// settled, or the empty [Result] and false if it is still pending. It never
+// blocks.
+func PeekResult[T any](p Promise[T]) (Result[T], bool) {
+	select {
+	case <-p.Done():
+		return WaitResult(p), true
+	default:
+		return Result[T]{}, false
+	}
+}
+
+type gobResult[T any] struct {
+	Value  T
+	Err    string
+	HasErr bool
+}
+
+// GobEncode implements the [gob.GobEncoder] interface, so the results can be
+// sent between processes. T must be gob-encodable. The error is encoded as its
+// message only, so the encoding is lossy: the decoded error is a plain error
+// with the same message, and [errors.Is] checks on it will not match the
+// original error.
+func (r Result[T]) GobEncode() ([]byte, error) {
+	w := gobResult[T]{Value: r.Value}
+	if r.Err != nil {
+		w.Err, w.HasErr = r.Err.Error(), true
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(w); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements the [gob.GobDecoder] interface, see
+// [Result.GobEncode].
+func (r *Result[T]) GobDecode(data []byte) error {
+	var w gobResult[T]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&w); err != nil {
+		return err
+	}
+	r.Value, r.Err = w.Value, nil
+	if w.HasErr {
+		r.Err = errors.New(w.Err)
+	}
+	return nil
+}
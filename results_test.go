@@ -0,0 +1,143 @@
+package promises_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestResultsSuite(t *testing.T) {
+	suite.Run(t, new(ResultsSuite))
+}
+
+type ResultsSuite struct {
+	suite.Suite
+}
+
+func (suite *ResultsSuite) TestMergeResults() {
+	tgtErr1 := errors.New("test error 1")
+	tgtErr2 := errors.New("test error 2")
+
+	batch1, err := promises.AllSettled(
+		promises.Resolve(41),
+		promises.Reject[int](tgtErr1),
+	).Wait()
+	suite.Nil(err)
+	batch2, err := promises.AllSettled(
+		promises.Resolve(42),
+		promises.Reject[int](tgtErr2),
+	).Wait()
+	suite.Nil(err)
+
+	merged := promises.MergeResults(batch1, nil, batch2)
+	suite.Equal(promises.Results[int]{
+		{41, nil},
+		{0, tgtErr1},
+		{42, nil},
+		{0, tgtErr2},
+	}, merged)
+	suite.Equal([]int{41, 42}, merged.Values())
+	suite.ErrorIs(merged.Err(), tgtErr1)
+	suite.ErrorIs(merged.Err(), tgtErr2)
+}
+
+func (suite *ResultsSuite) TestMergeResults_empty() {
+	merged := promises.MergeResults[int](nil, promises.Results[int]{})
+	suite.Nil(merged)
+	suite.Empty(merged.Values())
+	suite.Nil(merged.Err())
+}
+
+func (suite *ResultsSuite) TestMergeResults_no_errors() {
+	merged := promises.MergeResults(
+		promises.Results[int]{{41, nil}},
+		promises.Results[int]{{42, nil}},
+	)
+	suite.Equal([]int{41, 42}, merged.Values())
+	suite.Nil(merged.Err())
+}
+
+func (suite *ResultsSuite) TestWaitResult_resolved() {
+	r := promises.WaitResult(promises.Resolve(42))
+	suite.Equal(promises.Result[int]{Value: 42}, r)
+}
+
+func (suite *ResultsSuite) TestWaitResult_rejected() {
+	tgtErr := errors.New("test error")
+	r := promises.WaitResult(promises.Reject[int](tgtErr))
+	suite.Equal(promises.Result[int]{Err: tgtErr}, r)
+}
+
+func (suite *ResultsSuite) TestReflect_resolved() {
+	val, err := promises.Reflect(promises.Resolve(42)).Wait()
+	suite.Equal(promises.Result[int]{Value: 42}, val)
+	suite.Nil(err)
+}
+
+func (suite *ResultsSuite) TestReflect_rejected() {
+	tgtErr := errors.New("test error")
+	val, err := promises.Reflect(promises.Reject[int](tgtErr)).Wait()
+	suite.Equal(promises.Result[int]{Err: tgtErr}, val)
+	suite.Nil(err, "reflected promise should fulfill")
+}
+
+func (suite *ResultsSuite) TestReflect_all() {
+	tgtErr := errors.New("test error")
+	val, err := promises.All(
+		promises.Reflect(promises.Resolve(42)),
+		promises.Reflect(promises.Reject[int](tgtErr)),
+	).Wait()
+	suite.Equal([]promises.Result[int]{{42, nil}, {0, tgtErr}}, val)
+	suite.Nil(err)
+}
+
+func (suite *ResultsSuite) TestPeekResult_pending() {
+	promise, _, _ := promises.WithResolvers[int]()
+	r, ok := promises.PeekResult(promise)
+	suite.False(ok, "promise should not be settled")
+	suite.Equal(promises.Result[int]{}, r)
+}
+
+func (suite *ResultsSuite) TestPeekResult_settled() {
+	tgtErr := errors.New("test error")
+
+	r, ok := promises.PeekResult(promises.Resolve(42))
+	suite.True(ok, "promise should be settled")
+	suite.Equal(promises.Result[int]{Value: 42}, r)
+
+	r, ok = promises.PeekResult(promises.Reject[int](tgtErr))
+	suite.True(ok, "promise should be settled")
+	suite.Equal(promises.Result[int]{Err: tgtErr}, r)
+}
+
+func (suite *ResultsSuite) TestResults_gob() {
+	tgtErr := errors.New("test error")
+	rs := promises.Results[int]{{41, nil}, {0, tgtErr}, {43, nil}}
+
+	var buf bytes.Buffer
+	suite.Require().NoError(gob.NewEncoder(&buf).Encode(rs))
+
+	var decoded promises.Results[int]
+	suite.Require().NoError(gob.NewDecoder(&buf).Decode(&decoded))
+	suite.Require().Len(decoded, 3)
+	suite.Equal(promises.Result[int]{Value: 41}, decoded[0])
+	suite.Zero(decoded[1].Value)
+	suite.EqualError(decoded[1].Err, "test error")
+	suite.NotErrorIs(decoded[1].Err, tgtErr, "decoded error should be a copy")
+	suite.Equal(promises.Result[int]{Value: 43}, decoded[2])
+	suite.Equal([]int{41, 43}, decoded.Values())
+}
+
+func (suite *ResultsSuite) TestResult_gob_struct() {
+	type point struct{ X, Y int }
+	var buf bytes.Buffer
+	suite.Require().NoError(gob.NewEncoder(&buf).Encode(promises.Result[point]{Value: point{1, 2}}))
+
+	var decoded promises.Result[point]
+	suite.Require().NoError(gob.NewDecoder(&buf).Decode(&decoded))
+	suite.Equal(promises.Result[point]{Value: point{1, 2}}, decoded)
+}
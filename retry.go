@@ -0,0 +1,137 @@
+package promises
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Jitter is a strategy of randomizing the [Retryer] delays, that prevents the
+// retries of many clients from happening at the same time.
+type Jitter int
+
+// Jitter strategies. For the delay d calculated by the backoff:
+const (
+	// NoJitter uses the delay d as is.
+	NoJitter Jitter = iota
+	// FullJitter uses a random delay in [0, d).
+	FullJitter
+	// EqualJitter uses a random delay in [d/2, d).
+	EqualJitter
+)
+
+// Retryer is a reusable retry policy. It is a value type that is never modified
+// by the package, so the same Retryer can be safely used for many calls
+// concurrently. Use [RunRetryer] to run a function with the retries.
+type Retryer struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// Values less than 1 mean a single attempt.
+	MaxAttempts int
+	// Backoff is the delay before the second attempt. Each next delay is twice
+	// as long as the previous one.
+	Backoff time.Duration
+	// RetryIf reports whether the attempt failed with the given error should be
+	// retried. If it is nil, all errors are retried.
+	RetryIf func(error) bool
+	// Jitter is the strategy of delays randomization.
+	Jitter Jitter
+	// Source is the source of random numbers for the Jitter. If it is nil, the
+	// default source of the math/rand package is used. The Source is accessed
+	// under the package-wide lock, so it does not need to be safe for
+	// concurrent use.
+	Source rand.Source
+}
+
+// RunRetryer creates a promise that calls fn and, if it returns an error,
+// retries it according to the Retryer policy. The promise settles with the
+// result of the first successful attempt, or with the error of the last one.
+func RunRetryer[T any](r Retryer, fn func() (T, error)) Promise[T] {
+	return New(func() (T, error) { return retry(r, fn) })
+}
+
+// Delay returns the delay after the given failed attempt (starting from 1),
+// with the Jitter applied.
+func (r Retryer) Delay(attempt int) time.Duration {
+	d := r.Backoff
+	for i := 1; i < attempt && d <= math.MaxInt64/2; i++ {
+		d *= 2
+	}
+
+	switch r.Jitter {
+	case FullJitter:
+		return r.random(d)
+	case EqualJitter:
+		return d/2 + r.random(d-d/2)
+	default:
+		return d
+	}
+}
+
+// sourceMu guards the custom Retryer sources.
+var sourceMu sync.Mutex
+
+// random returns a random duration in [0, n).
+func (r Retryer) random(n time.Duration) time.Duration {
+	if n <= 0 {
+		return 0
+	}
+	if r.Source == nil {
+		return time.Duration(rand.Int63n(int64(n)))
+	}
+	sourceMu.Lock()
+	defer sourceMu.Unlock()
+	return time.Duration(rand.New(r.Source).Int63n(int64(n)))
+}
+
+func (r Retryer) shouldRetry(attempt int, err error) bool {
+	return attempt < r.MaxAttempts && (r.RetryIf == nil || r.RetryIf(err))
+}
+
+func retry[T any](r Retryer, fn func() (T, error)) (T, error) {
+	for attempt := 1; ; attempt++ {
+		v, err := fn()
+		if err == nil {
+			return v, nil
+		}
+		if !r.shouldRetry(attempt, err) {
+			return zero[T](), err
+		}
+		if d := r.Delay(attempt); d > 0 {
+			<-currentClock().After(d)
+		}
+	}
+}
+
+// RetryOn creates a promise that calls the factory and waits for the produced
+// promise. If it rejects with the error for which shouldRetry returns true, the
+// factory is called again, up to maxAttempts times in total. Unlike
+// [RunRetryer], it works with the promise-producing operations. If shouldRetry
+// is nil, all errors are retried.
+func RetryOn[T any](factory func() Promise[T], shouldRetry func(error) bool, maxAttempts int) Promise[T] {
+	r := Retryer{MaxAttempts: maxAttempts, RetryIf: shouldRetry}
+	return RunRetryer(r, func() (T, error) { return factory().Wait() })
+}
+
+// ThenRetry acts like [Then], but if gen returns an error, it is called again
+// with the same value, up to attempts times in total, without delays. The
+// promise p itself is awaited once and never retried, so it suits the
+// expensive or single-shot sources followed by a flaky step. Use [RunRetryer]
+// inside the [Then] callback for the more complex retry policies.
+func ThenRetry[T, P any](p Promise[T], attempts int, gen func(T) (P, error)) Promise[P] {
+	r := Retryer{MaxAttempts: attempts}
+	return Then(p, func(v T) (P, error) {
+		return retry(r, func() (P, error) { return gen(v) })
+	})
+}
+
+// RetryAll creates a promise that calls the factory to produce a batch of
+// promises and waits for all of them (see [All]). If any of them rejects, the
+// factory is called again for a fresh batch, up to maxAttempts times in total.
+// It is useful for the all-or-nothing batches, where any failure requires to
+// redo the whole batch. The promise settles with the result of the last
+// attempt.
+func RetryAll[T any](maxAttempts int, factory func() []Promise[T]) Promise[[]T] {
+	r := Retryer{MaxAttempts: maxAttempts}
+	return RunRetryer(r, func() ([]T, error) { return All(factory()...).Wait() })
+}
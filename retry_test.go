@@ -0,0 +1,198 @@
+package promises_test
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRetrySuite(t *testing.T) {
+	suite.Run(t, new(RetrySuite))
+}
+
+type RetrySuite struct {
+	suite.Suite
+}
+
+// failingTimes returns a function that fails n times and then returns value.
+func failingTimes[T any](n int, value T) (fn func() (T, error), calls *int) {
+	calls = new(int)
+	return func() (T, error) {
+		*calls++
+		if *calls <= n {
+			return *new(T), errors.New("test error")
+		}
+		return value, nil
+	}, calls
+}
+
+func (suite *RetrySuite) TestRunRetryer_reuse() {
+	r := promises.Retryer{MaxAttempts: 3, Backoff: time.Millisecond}
+
+	fn1, calls1 := failingTimes(2, 42)
+	fn2, calls2 := failingTimes(1, "foo")
+
+	val1, err := promises.RunRetryer(r, fn1).Wait()
+	suite.Equal(42, val1)
+	suite.Nil(err)
+	suite.Equal(3, *calls1)
+
+	val2, err := promises.RunRetryer(r, fn2).Wait()
+	suite.Equal("foo", val2)
+	suite.Nil(err)
+	suite.Equal(2, *calls2)
+}
+
+func (suite *RetrySuite) TestRunRetryer_exhausted() {
+	r := promises.Retryer{MaxAttempts: 2}
+	fn, calls := failingTimes(5, 42)
+
+	val, err := promises.RunRetryer(r, fn).Wait()
+	suite.Zero(val)
+	suite.EqualError(err, "test error")
+	suite.Equal(2, *calls)
+}
+
+func (suite *RetrySuite) TestRunRetryer_RetryIf() {
+	r := promises.Retryer{
+		MaxAttempts: 5,
+		RetryIf:     func(error) bool { return false },
+	}
+	fn, calls := failingTimes(1, 42)
+
+	_, err := promises.RunRetryer(r, fn).Wait()
+	suite.Error(err)
+	suite.Equal(1, *calls)
+}
+
+func (suite *RetrySuite) TestDelay() {
+	r := promises.Retryer{Backoff: 10 * time.Millisecond}
+	suite.Equal(10*time.Millisecond, r.Delay(1))
+	suite.Equal(20*time.Millisecond, r.Delay(2))
+	suite.Equal(40*time.Millisecond, r.Delay(3))
+}
+
+func (suite *RetrySuite) TestDelay_FullJitter() {
+	r := promises.Retryer{
+		Backoff: 10 * time.Millisecond,
+		Jitter:  promises.FullJitter,
+		Source:  rand.NewSource(1),
+	}
+	for attempt := 1; attempt <= 5; attempt++ {
+		base := promises.Retryer{Backoff: r.Backoff}.Delay(attempt)
+		for i := 0; i < 100; i++ {
+			d := r.Delay(attempt)
+			suite.GreaterOrEqual(d, time.Duration(0))
+			suite.Less(d, base)
+		}
+	}
+}
+
+func (suite *RetrySuite) TestDelay_EqualJitter() {
+	r := promises.Retryer{
+		Backoff: 10 * time.Millisecond,
+		Jitter:  promises.EqualJitter,
+		Source:  rand.NewSource(1),
+	}
+	for attempt := 1; attempt <= 5; attempt++ {
+		base := promises.Retryer{Backoff: r.Backoff}.Delay(attempt)
+		for i := 0; i < 100; i++ {
+			d := r.Delay(attempt)
+			suite.GreaterOrEqual(d, base/2)
+			suite.Less(d, base)
+		}
+	}
+}
+
+func (suite *RetrySuite) TestDelay_deterministic() {
+	r1 := promises.Retryer{Backoff: time.Second, Jitter: promises.FullJitter, Source: rand.NewSource(42)}
+	r2 := promises.Retryer{Backoff: time.Second, Jitter: promises.FullJitter, Source: rand.NewSource(42)}
+	for attempt := 1; attempt <= 5; attempt++ {
+		suite.Equal(r1.Delay(attempt), r2.Delay(attempt))
+	}
+}
+
+func (suite *RetrySuite) TestRetryOn() {
+	fn, calls := failingTimes(2, 42)
+	factory := func() promises.Promise[int] { return promises.New(fn) }
+
+	val, err := promises.RetryOn(factory, nil, 3).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+	suite.Equal(3, *calls)
+}
+
+func (suite *RetrySuite) TestRetryOn_not_retryable() {
+	fn, calls := failingTimes(2, 42)
+	factory := func() promises.Promise[int] { return promises.New(fn) }
+
+	_, err := promises.RetryOn(factory, func(error) bool { return false }, 3).Wait()
+	suite.EqualError(err, "test error")
+	suite.Equal(1, *calls)
+}
+
+func (suite *RetrySuite) TestRetryAll() {
+	tgtErr := errors.New("test error")
+	batches := 0
+	factory := func() []promises.Promise[int] {
+		batches++
+		if batches == 1 {
+			return []promises.Promise[int]{promises.Resolve(41), promises.Reject[int](tgtErr)}
+		}
+		return []promises.Promise[int]{promises.Resolve(41), promises.Resolve(42)}
+	}
+
+	val, err := promises.RetryAll(3, factory).Wait()
+	suite.Equal([]int{41, 42}, val)
+	suite.Nil(err)
+	suite.Equal(2, batches)
+}
+
+func (suite *RetrySuite) TestRetryAll_exhausted() {
+	tgtErr := errors.New("test error")
+	batches := 0
+	factory := func() []promises.Promise[int] {
+		batches++
+		return []promises.Promise[int]{promises.Reject[int](tgtErr)}
+	}
+
+	val, err := promises.RetryAll(3, factory).Wait()
+	suite.Nil(val)
+	suite.ErrorIs(err, tgtErr)
+	suite.Equal(3, batches)
+}
+
+func (suite *RetrySuite) TestThenRetry() {
+	source, sourceCalls := failingTimes(0, 21)
+	gen, genCalls := failingTimes(1, "ok")
+
+	val, err := promises.ThenRetry(promises.New(source), 3, func(v int) (string, error) {
+		s, err := gen()
+		return s + strconv.Itoa(v), err
+	}).Wait()
+	suite.Equal("ok21", val)
+	suite.Nil(err)
+	suite.Equal(2, *genCalls)
+	suite.Equal(1, *sourceCalls, "source should not be retried")
+}
+
+func (suite *RetrySuite) TestThenRetry_exhausted() {
+	gen, calls := failingTimes(5, "ok")
+	val, err := promises.ThenRetry(promises.Resolve(21), 3, func(int) (string, error) { return gen() }).Wait()
+	suite.Empty(val)
+	suite.EqualError(err, "test error")
+	suite.Equal(3, *calls)
+}
+
+func (suite *RetrySuite) TestThenRetry_source_rejected() {
+	tgtErr := errors.New("source error")
+	gen, calls := failingTimes(0, "ok")
+	_, err := promises.ThenRetry(promises.Reject[int](tgtErr), 3, func(int) (string, error) { return gen() }).Wait()
+	suite.ErrorIs(err, tgtErr)
+	suite.Equal(0, *calls)
+}
@@ -0,0 +1,65 @@
+package promises
+
+import (
+	"errors"
+	"sync"
+)
+
+// RetryingPool runs the submitted functions on a fixed number of workers and
+// retries the failed ones according to the [Retryer] policy. The failed
+// function doesn't hold its worker during the backoff delay: it is queued again
+// after the delay. Use [SubmitRetrying] to submit a function.
+type RetryingPool struct {
+	scheduler *Scheduler
+	retryer   Retryer
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewRetryingPool creates a new [RetryingPool] with the given number of workers
+// (at least one) and the retry policy.
+func NewRetryingPool(workers int, retryer Retryer) *RetryingPool {
+	return &RetryingPool{
+		scheduler: NewScheduler(workers),
+		retryer:   retryer,
+		closed:    make(chan struct{}),
+	}
+}
+
+// SubmitRetrying submits fn to the pool and returns a promise that settles with
+// the result of the first successful attempt, or with the error of the last one
+// when the retries are exhausted. If the pool is closed, the promise is
+// rejected with [ErrSchedulerClosed].
+func SubmitRetrying[T any](pool *RetryingPool, fn func() (T, error)) Promise[T] {
+	p, resolve, reject := WithResolvers[T]()
+	var attempt func(n int)
+	attempt = func(n int) {
+		Link(Schedule(pool.scheduler, 0, fn), resolve, func(err error) {
+			if errors.Is(err, ErrSchedulerClosed) || !pool.retryer.shouldRetry(n, err) {
+				reject(err)
+				return
+			}
+			go func() {
+				timer := currentClock().NewTimer(pool.retryer.Delay(n))
+				defer timer.Stop()
+				select {
+				case <-timer.C():
+					attempt(n + 1)
+				case <-pool.closed:
+					reject(ErrSchedulerClosed)
+				}
+			}()
+		})
+	}
+	attempt(1)
+	return p
+}
+
+// Close closes the pool. The functions that are not started yet are rejected
+// with [ErrSchedulerClosed], including the ones waiting for the retry: their
+// backoff delays are canceled. The running functions are not interrupted, but
+// they are not retried anymore. Close is idempotent.
+func (pool *RetryingPool) Close() {
+	pool.closeOnce.Do(func() { close(pool.closed) })
+	pool.scheduler.Close()
+}
@@ -0,0 +1,87 @@
+package promises_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRetryingPoolSuite(t *testing.T) {
+	suite.Run(t, new(RetryingPoolSuite))
+}
+
+type RetryingPoolSuite struct {
+	suite.Suite
+}
+
+func (suite *RetryingPoolSuite) TestSubmitRetrying() {
+	pool := promises.NewRetryingPool(2, promises.Retryer{
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+	})
+	defer pool.Close()
+
+	fn, calls := failingTimes(2, 42)
+	val, err := promises.SubmitRetrying(pool, fn).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+	suite.Equal(3, *calls)
+}
+
+func (suite *RetryingPoolSuite) TestSubmitRetrying_exhausted() {
+	pool := promises.NewRetryingPool(2, promises.Retryer{MaxAttempts: 2})
+	defer pool.Close()
+
+	fn, calls := failingTimes(5, 42)
+	val, err := promises.SubmitRetrying(pool, fn).Wait()
+	suite.Zero(val)
+	suite.EqualError(err, "test error")
+	suite.Equal(2, *calls)
+}
+
+func (suite *RetryingPoolSuite) TestSubmitRetrying_closed() {
+	pool := promises.NewRetryingPool(1, promises.Retryer{
+		MaxAttempts: 5,
+		Backoff:     20 * time.Millisecond,
+	})
+
+	var mu sync.Mutex
+	calls := 0
+	promise := promises.SubmitRetrying(pool, func() (int, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return 0, promises.ErrTimeout
+	})
+	time.Sleep(10 * time.Millisecond)
+	pool.Close()
+
+	_, err := promise.Wait()
+	suite.ErrorIs(err, promises.ErrSchedulerClosed)
+	mu.Lock()
+	defer mu.Unlock()
+	suite.Equal(1, calls)
+}
+
+func (suite *RetryingPoolSuite) TestSubmitRetrying_closed_during_backoff() {
+	pool := promises.NewRetryingPool(1, promises.Retryer{
+		MaxAttempts: 5,
+		Backoff:     time.Hour,
+	})
+
+	fn, calls := failingTimes(5, 42)
+	promise := promises.SubmitRetrying(pool, fn)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should wait for the backoff")
+
+	start := time.Now()
+	pool.Close()
+	pool.Close()
+	_, err := promise.WaitFor(time.Second)
+	suite.ErrorIs(err, promises.ErrSchedulerClosed)
+	suite.Less(time.Since(start), time.Second, "backoff should be canceled")
+	suite.Equal(1, *calls)
+}
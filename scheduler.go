@@ -0,0 +1,126 @@
+package promises
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// Scheduler runs the scheduled functions on a fixed number of workers. When all
+// workers are busy, the functions are queued, and the higher-priority ones are
+// run first. Functions with the same priority are run in the order of
+// scheduling. Use [Schedule] to schedule a function.
+type Scheduler struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  taskQueue
+	seq    uint64
+	closed bool
+}
+
+// NewScheduler creates a new Scheduler with the given number of workers (at
+// least one).
+func NewScheduler(workers int) *Scheduler {
+	s := new(Scheduler)
+	s.cond = sync.NewCond(&s.mu)
+	for i := 0; i < max(workers, 1); i++ {
+		go s.work()
+	}
+	return s
+}
+
+// Schedule schedules fn to run on the scheduler with the given priority, and
+// returns a promise that settles with the fn result. If the scheduler is
+// closed, the promise is rejected with [ErrSchedulerClosed].
+func Schedule[T any](s *Scheduler, priority int, fn func() (T, error)) Promise[T] {
+	p, resolve, reject := WithResolvers[T]()
+	ok := s.push(&scheduledTask{
+		priority: priority,
+		run:      func() { runGen(fn, resolve, reject) },
+		cancel:   func() { reject(ErrSchedulerClosed) },
+	})
+	if !ok {
+		reject(ErrSchedulerClosed)
+	}
+	return p
+}
+
+// Close closes the scheduler. The queued functions that are not started yet
+// are not called, and their promises are rejected with [ErrSchedulerClosed].
+// The running functions are not interrupted.
+func (s *Scheduler) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+	queue := s.queue
+	s.queue = nil
+	s.mu.Unlock()
+
+	s.cond.Broadcast()
+	for _, t := range queue {
+		t.cancel()
+	}
+}
+
+func (s *Scheduler) push(t *scheduledTask) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return false
+	}
+	t.seq = s.seq
+	s.seq++
+	heap.Push(&s.queue, t)
+	s.cond.Signal()
+	return true
+}
+
+func (s *Scheduler) work() {
+	for {
+		s.mu.Lock()
+		for len(s.queue) == 0 && !s.closed {
+			s.cond.Wait()
+		}
+		if s.closed {
+			s.mu.Unlock()
+			return
+		}
+		t := heap.Pop(&s.queue).(*scheduledTask)
+		s.mu.Unlock()
+
+		t.run()
+	}
+}
+
+type scheduledTask struct {
+	priority int
+	seq      uint64
+	run      func()
+	cancel   func()
+}
+
+// taskQueue implements heap.Interface, the highest priority task is on top.
+type taskQueue []*scheduledTask
+
+func (q taskQueue) Len() int { return len(q) }
+
+func (q taskQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q taskQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *taskQueue) Push(x any) { *q = append(*q, x.(*scheduledTask)) }
+
+func (q *taskQueue) Pop() any {
+	old := *q
+	t := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return t
+}
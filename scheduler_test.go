@@ -0,0 +1,88 @@
+package promises_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSchedulerSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerSuite))
+}
+
+type SchedulerSuite struct {
+	suite.Suite
+}
+
+func (suite *SchedulerSuite) TestSchedule() {
+	s := promises.NewScheduler(2)
+	defer s.Close()
+
+	val, err := promises.Schedule(s, 0, func() (int, error) { return 42, nil }).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *SchedulerSuite) TestSchedule_priority() {
+	s := promises.NewScheduler(1)
+	defer s.Close()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	blocker := promises.Schedule(s, 0, func() (int, error) {
+		close(started)
+		<-release
+		return 0, nil
+	})
+	<-started
+
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) func() (string, error) {
+		return func() (string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, name)
+			return name, nil
+		}
+	}
+
+	low := promises.Schedule(s, 0, record("low"))
+	mid := promises.Schedule(s, 5, record("mid"))
+	high := promises.Schedule(s, 10, record("high"))
+	close(release)
+
+	_, err := promises.All(low, mid, high).Wait()
+	suite.Nil(err)
+	_, err = blocker.Wait()
+	suite.Nil(err)
+	suite.Equal([]string{"high", "mid", "low"}, order)
+}
+
+func (suite *SchedulerSuite) TestClose() {
+	s := promises.NewScheduler(1)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	running := promises.Schedule(s, 0, func() (int, error) {
+		close(started)
+		<-release
+		return 42, nil
+	})
+	<-started
+	queued := promises.Schedule(s, 0, func() (int, error) { return 43, nil })
+
+	s.Close()
+	_, err := queued.Wait()
+	suite.ErrorIs(err, promises.ErrSchedulerClosed)
+
+	_, err = promises.Schedule(s, 0, func() (int, error) { return 44, nil }).Wait()
+	suite.ErrorIs(err, promises.ErrSchedulerClosed)
+
+	close(release)
+	val, err := running.Wait()
+	suite.Equal(42, val, "running function should not be interrupted")
+	suite.Nil(err)
+}
@@ -0,0 +1,72 @@
+package promises
+
+import "sync"
+
+// Selector races a dynamic set of promises: the promises can be added at any
+// time with Add, and each Next call returns the next of them to settle. The
+// zero value is an empty selector ready to use. It is safe for concurrent use.
+type Selector[T any] struct {
+	mu      sync.Mutex
+	nextID  int
+	settled []Selected[T]
+	waiters []func(Selected[T])
+}
+
+// Selected is the settled promise reported by [Selector.Next].
+type Selected[T any] struct {
+	// ID is the ID of the promise returned by [Selector.Add].
+	ID int
+	// Result is the outcome of the promise.
+	Result Result[T]
+}
+
+// Add adds the promise to the selector and returns its ID, the sequential
+// number of the promise in the selector (starting from 0). If the promise is
+// already settled, it is queued for Next immediately.
+func (s *Selector[T]) Add(p Promise[T]) int {
+	s.mu.Lock()
+	id := s.nextID
+	s.nextID++
+	s.mu.Unlock()
+
+	select {
+	case <-p.Done():
+		s.deliver(id, WaitResult(p))
+	default:
+		OnSettle(p, func(v T, err error) { s.deliver(id, Result[T]{v, err}) })
+	}
+	return id
+}
+
+// Next returns a promise that fulfills with the ID and the outcome of the next
+// added promise to settle. Each settled promise is reported by exactly one
+// Next call, in the order of settlement; the concurrent Next calls are served
+// in the order of calling. The returned promise never rejects, and stays
+// pending until some added promise settles.
+func (s *Selector[T]) Next() Promise[Selected[T]] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.settled) > 0 {
+		next := s.settled[0]
+		s.settled = s.settled[1:]
+		return Resolve(next)
+	}
+	p, resolve, _ := WithResolvers[Selected[T]]()
+	s.waiters = append(s.waiters, resolve)
+	return p
+}
+
+func (s *Selector[T]) deliver(id int, r Result[T]) {
+	next := Selected[T]{id, r}
+
+	s.mu.Lock()
+	if len(s.waiters) == 0 {
+		s.settled = append(s.settled, next)
+		s.mu.Unlock()
+		return
+	}
+	resolve := s.waiters[0]
+	s.waiters = s.waiters[1:]
+	s.mu.Unlock()
+	resolve(next)
+}
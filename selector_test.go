@@ -0,0 +1,77 @@
+package promises_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSelectorSuite(t *testing.T) {
+	suite.Run(t, new(SelectorSuite))
+}
+
+type SelectorSuite struct {
+	suite.Suite
+}
+
+func (suite *SelectorSuite) TestSelector() {
+	tgtErr := errors.New("test error")
+	var s promises.Selector[int]
+
+	p1, resolve1, _ := promises.WithResolvers[int]()
+	p2, _, reject2 := promises.WithResolvers[int]()
+	suite.Equal(0, s.Add(p1))
+	suite.Equal(1, s.Add(p2))
+
+	next := s.Next()
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(next), "next should not be settled")
+
+	reject2(tgtErr)
+	val, err := next.Wait()
+	suite.Nil(err)
+	suite.Equal(1, val.ID)
+	suite.Equal(promises.Result[int]{Err: tgtErr}, val.Result)
+
+	p3, resolve3, _ := promises.WithResolvers[int]()
+	suite.Equal(2, s.Add(p3))
+	resolve3(43)
+	val, _ = s.Next().Wait()
+	suite.Equal(2, val.ID)
+	suite.Equal(promises.Result[int]{Value: 43}, val.Result)
+
+	resolve1(41)
+	val, _ = s.Next().Wait()
+	suite.Equal(0, val.ID)
+	suite.Equal(promises.Result[int]{Value: 41}, val.Result)
+}
+
+func (suite *SelectorSuite) TestSelector_already_settled() {
+	var s promises.Selector[int]
+	pending, _, _ := promises.WithResolvers[int]()
+	s.Add(pending)
+	id := s.Add(promises.Resolve(42))
+
+	next := s.Next()
+	suite.True(isSettled(next), "settled promise should be considered immediately")
+	val, _ := next.Wait()
+	suite.Equal(id, val.ID)
+	suite.Equal(42, val.Result.Value)
+}
+
+func (suite *SelectorSuite) TestSelector_waiters_order() {
+	var s promises.Selector[int]
+	next1 := s.Next()
+	next2 := s.Next()
+
+	s.Add(promises.Resolve(41))
+	s.Add(promises.Resolve(42))
+
+	val1, _ := next1.Wait()
+	val2, _ := next2.Wait()
+	suite.Equal(41, val1.Result.Value)
+	suite.Equal(42, val2.Result.Value)
+}
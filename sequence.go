@@ -0,0 +1,41 @@
+package promises
+
+// WithFallbacks creates a promise that calls the primary function and, if it
+// returns an error, calls the fallbacks one by one until one of them succeeds.
+// The promise fulfills with the first successful result, or rejects with an
+// [AggregateError] of all failures if every function fails. The functions are
+// called sequentially in the promise's goroutine; use [Any] to try them in
+// parallel.
+func WithFallbacks[T any](primary func() (T, error), fallbacks ...func() (T, error)) Promise[T] {
+	fns := append([]func() (T, error){primary}, fallbacks...)
+	return New(func() (T, error) {
+		errs := make([]error, len(fns))
+		for i, fn := range fns {
+			v, err := fn()
+			if err == nil {
+				return v, nil
+			}
+			errs[i] = err
+		}
+		return zero[T](), &AggregateError{errs}
+	})
+}
+
+// Sequence creates a promise that calls the functions strictly one after
+// another in the promise's goroutine, and fulfills with their results in
+// order. It stops at the first error and rejects with it; the rest of the
+// functions are not called. It is the sequential counterpart of [All] for the
+// cases when the side effects must happen in order (e.g. migrations).
+func Sequence[T any](fns ...func() (T, error)) Promise[[]T] {
+	return New(func() ([]T, error) {
+		values := make([]T, len(fns))
+		for i, fn := range fns {
+			v, err := fn()
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		return values, nil
+	})
+}
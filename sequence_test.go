@@ -0,0 +1,87 @@
+package promises_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSequenceSuite(t *testing.T) {
+	suite.Run(t, new(SequenceSuite))
+}
+
+type SequenceSuite struct {
+	suite.Suite
+}
+
+func (suite *SequenceSuite) TestWithFallbacks_primary() {
+	called := false
+	promise := promises.WithFallbacks(
+		func() (int, error) { return 42, nil },
+		func() (int, error) { called = true; return 43, nil },
+	)
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+	suite.False(called, "fallback should not be called")
+}
+
+func (suite *SequenceSuite) TestWithFallbacks_fallback() {
+	promise := promises.WithFallbacks(
+		func() (int, error) { return 0, errors.New("test error 1") },
+		func() (int, error) { return 0, errors.New("test error 2") },
+		func() (int, error) { return 42, nil },
+	)
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *SequenceSuite) TestWithFallbacks_all_fail() {
+	tgtErr1 := errors.New("test error 1")
+	tgtErr2 := errors.New("test error 2")
+	promise := promises.WithFallbacks(
+		func() (int, error) { return 0, tgtErr1 },
+		func() (int, error) { return 0, tgtErr2 },
+	)
+	val, err := promise.Wait()
+	suite.Zero(val)
+	var expectedErr *promises.AggregateError
+	suite.ErrorAs(err, &expectedErr)
+	suite.Equal([]error{tgtErr1, tgtErr2}, expectedErr.Errors)
+}
+
+func (suite *SequenceSuite) TestSequence() {
+	var calls []int
+	step := func(i int) func() (int, error) {
+		return func() (int, error) {
+			calls = append(calls, i)
+			return i * 10, nil
+		}
+	}
+	val, err := promises.Sequence(step(1), step(2), step(3)).Wait()
+	suite.Equal([]int{10, 20, 30}, val)
+	suite.Nil(err)
+	suite.Equal([]int{1, 2, 3}, calls)
+}
+
+func (suite *SequenceSuite) TestSequence_error() {
+	tgtErr := errors.New("test error")
+	var calls []int
+	val, err := promises.Sequence(
+		func() (int, error) { calls = append(calls, 1); return 10, nil },
+		func() (int, error) { calls = append(calls, 2); return 0, tgtErr },
+		func() (int, error) { calls = append(calls, 3); return 30, nil },
+	).Wait()
+	suite.Nil(val)
+	suite.ErrorIs(err, tgtErr)
+	suite.Equal([]int{1, 2}, calls, "functions after the failed one should not be called")
+}
+
+func (suite *SequenceSuite) TestSequence_empty() {
+	val, err := promises.Sequence[int]().Wait()
+	suite.Empty(val)
+	suite.Nil(err)
+}
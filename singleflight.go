@@ -0,0 +1,42 @@
+package promises
+
+import "sync"
+
+// SingleFlightGroup coalesces the concurrent calls with the same key into one
+// execution. Use [SingleFlight] to create it.
+type SingleFlightGroup[K comparable, T any] struct {
+	mu       sync.Mutex
+	inFlight map[K]Promise[T]
+}
+
+// SingleFlight creates a new [SingleFlightGroup].
+func SingleFlight[K comparable, T any]() *SingleFlightGroup[K, T] {
+	return &SingleFlightGroup[K, T]{inFlight: make(map[K]Promise[T])}
+}
+
+// Do runs fn in a separate goroutine and returns a promise that settles with
+// its result. If there is already an in-flight call with the same key, Do
+// returns the promise of that call instead and doesn't call fn. Once the call
+// is completed, the key is forgotten, so the next Do with this key calls fn
+// again.
+func (g *SingleFlightGroup[K, T]) Do(key K, fn func() (T, error)) Promise[T] {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if p, ok := g.inFlight[key]; ok {
+		return p
+	}
+
+	p, resolve, reject := WithResolvers[T]()
+	g.inFlight[key] = p
+	go runGen(func() (T, error) {
+		defer g.forget(key)
+		return fn()
+	}, resolve, reject)
+	return p
+}
+
+func (g *SingleFlightGroup[K, T]) forget(key K) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.inFlight, key)
+}
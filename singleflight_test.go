@@ -0,0 +1,75 @@
+package promises_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSingleFlightSuite(t *testing.T) {
+	suite.Run(t, new(SingleFlightSuite))
+}
+
+type SingleFlightSuite struct {
+	suite.Suite
+}
+
+func (suite *SingleFlightSuite) TestDo_coalesce() {
+	g := promises.SingleFlight[string, int]()
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fn := func() (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	const callers = 10
+	ps := make([]promises.Promise[int], callers)
+	wg := new(sync.WaitGroup)
+	wg.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func(i int) {
+			defer wg.Done()
+			ps[i] = g.Do("key", fn)
+		}(i)
+	}
+	wg.Wait()
+	close(release)
+
+	val, err := promises.All(ps...).Wait()
+	suite.Nil(err)
+	for _, v := range val {
+		suite.Equal(42, v)
+	}
+	suite.Equal(int32(1), calls.Load(), "fn should be called once")
+}
+
+func (suite *SingleFlightSuite) TestDo_different_keys() {
+	g := promises.SingleFlight[string, int]()
+	release := make(chan struct{})
+	p1 := g.Do("a", func() (int, error) { <-release; return 41, nil })
+	p2 := g.Do("b", func() (int, error) { <-release; return 42, nil })
+	close(release)
+
+	val, err := promises.All(p1, p2).Wait()
+	suite.Equal([]int{41, 42}, val)
+	suite.Nil(err)
+}
+
+func (suite *SingleFlightSuite) TestDo_reexecute() {
+	g := promises.SingleFlight[string, int]()
+	var calls atomic.Int32
+	fn := func() (int, error) { return int(calls.Add(1)), nil }
+
+	val, err := g.Do("key", fn).Wait()
+	suite.Equal(1, val)
+	suite.Nil(err)
+
+	val, err = g.Do("key", fn).Wait()
+	suite.Equal(2, val, "fn should be called again after completion")
+	suite.Nil(err)
+}
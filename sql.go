@@ -0,0 +1,58 @@
+package promises
+
+import (
+	"context"
+	"database/sql"
+)
+
+// FromQuery creates a promise that runs the query function in a separate
+// goroutine and resolves with the returned rows, or rejects with the returned
+// error. The promise also rejects with ctx.Err() if the context is done before
+// the query returns; the rows returned after that are closed automatically.
+//
+// The caller owns the rows of the fulfilled promise and must close them.
+func FromQuery(ctx context.Context, fn func(context.Context) (*sql.Rows, error)) Promise[*sql.Rows] {
+	return New(func() (*sql.Rows, error) {
+		query := New(func() (*sql.Rows, error) { return fn(ctx) })
+		select {
+		case <-query.Done():
+			return query.Wait()
+		case <-ctx.Done():
+			// Nobody will receive the rows
+			Link(query, closeRows, nil)
+			return nil, ctx.Err()
+		}
+	})
+}
+
+func closeRows(rows *sql.Rows) {
+	if rows != nil {
+		rows.Close()
+	}
+}
+
+// Transact creates a promise that runs fn in a database transaction in a
+// separate goroutine. The transaction is committed if fn returns nil, and the
+// promise resolves then. If fn returns an error or panics, the transaction is
+// rolled back, and the promise rejects with the error or with [ErrPanic]. The
+// commit error rejects the promise too. The transaction is bound to ctx, so
+// it is rolled back by [database/sql] if the context is done before commit.
+func Transact(ctx context.Context, db *sql.DB, fn func(context.Context, *sql.Tx) error) Promise[struct{}] {
+	return NewVoid(func() error {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if r := recover(); r != nil {
+				_ = tx.Rollback()
+				panic(r)
+			}
+		}()
+		if err := fn(ctx, tx); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	})
+}
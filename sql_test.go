@@ -0,0 +1,147 @@
+package promises_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSQLSuite(t *testing.T) {
+	suite.Run(t, new(SQLSuite))
+}
+
+type SQLSuite struct {
+	suite.Suite
+	db *sql.DB
+}
+
+func (suite *SQLSuite) SetupTest() {
+	db, err := sql.Open("fakedb", "")
+	suite.Require().NoError(err)
+	suite.db = db
+}
+
+func (suite *SQLSuite) TearDownTest() {
+	suite.db.Close()
+}
+
+func (suite *SQLSuite) TestFromQuery() {
+	rows, err := promises.FromQuery(
+		context.Background(),
+		func(ctx context.Context) (*sql.Rows, error) {
+			return suite.db.QueryContext(ctx, "select")
+		},
+	).Wait()
+	suite.Require().NoError(err)
+	defer rows.Close()
+
+	var values []int
+	for rows.Next() {
+		var n int
+		suite.Require().NoError(rows.Scan(&n))
+		values = append(values, n)
+	}
+	suite.Equal([]int{1, 2, 3}, values)
+}
+
+func (suite *SQLSuite) TestFromQuery_error() {
+	rows, err := promises.FromQuery(
+		context.Background(),
+		func(ctx context.Context) (*sql.Rows, error) {
+			return suite.db.QueryContext(ctx, "fail")
+		},
+	).Wait()
+	suite.Nil(rows)
+	suite.ErrorIs(err, errFakeQuery)
+}
+
+func (suite *SQLSuite) TestFromQuery_canceled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	db := suite.db
+	promise := promises.FromQuery(ctx, func(ctx context.Context) (*sql.Rows, error) {
+		defer close(finished)
+		<-release
+		return db.QueryContext(context.Background(), "select")
+	})
+
+	closed := fakeRowsClosed.Load()
+	cancel()
+	rows, err := promise.Wait()
+	suite.Nil(rows)
+	suite.ErrorIs(err, context.Canceled)
+
+	close(release)
+	<-finished
+	suite.Eventually(func() bool {
+		return fakeRowsClosed.Load() == closed+1
+	}, time.Second, time.Millisecond, "late rows should be closed")
+	suite.Eventually(func() bool {
+		return db.Stats().InUse == 0
+	}, time.Second, time.Millisecond, "connection should be released")
+}
+
+func (suite *SQLSuite) TestFromQuery_canceled_nil_rows() {
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	promise := promises.FromQuery(ctx, func(ctx context.Context) (*sql.Rows, error) {
+		defer close(finished)
+		<-release
+		return nil, nil
+	})
+
+	cancel()
+	_, err := promise.Wait()
+	suite.ErrorIs(err, context.Canceled)
+
+	close(release)
+	<-finished
+}
+
+func (suite *SQLSuite) TestFromQuery_panic() {
+	_, err := promises.FromQuery(context.Background(), func(ctx context.Context) (*sql.Rows, error) {
+		panic("AAA!")
+	}).Wait()
+	var panicErr *promises.ErrPanic
+	suite.ErrorAs(err, &panicErr)
+}
+
+func (suite *SQLSuite) TestTransact_commit() {
+	commits, rollbacks := fakeCommits.Load(), fakeRollbacks.Load()
+	_, err := promises.Transact(context.Background(), suite.db, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "insert")
+		return err
+	}).Wait()
+	suite.Nil(err)
+	suite.Equal(commits+1, fakeCommits.Load(), "transaction should be committed")
+	suite.Equal(rollbacks, fakeRollbacks.Load())
+}
+
+func (suite *SQLSuite) TestTransact_error() {
+	commits, rollbacks := fakeCommits.Load(), fakeRollbacks.Load()
+	_, err := promises.Transact(context.Background(), suite.db, func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.QueryContext(ctx, "fail")
+		return err
+	}).Wait()
+	suite.ErrorIs(err, errFakeQuery)
+	suite.Equal(commits, fakeCommits.Load())
+	suite.Equal(rollbacks+1, fakeRollbacks.Load(), "transaction should be rolled back")
+}
+
+func (suite *SQLSuite) TestTransact_panic() {
+	commits, rollbacks := fakeCommits.Load(), fakeRollbacks.Load()
+	_, err := promises.Transact(context.Background(), suite.db, func(ctx context.Context, tx *sql.Tx) error {
+		panic("AAA!")
+	}).Wait()
+	var panicErr *promises.ErrPanic
+	suite.Require().ErrorAs(err, &panicErr)
+	suite.Equal("AAA!", panicErr.Value)
+	suite.Equal(commits, fakeCommits.Load())
+	suite.Equal(rollbacks+1, fakeRollbacks.Load(), "transaction should be rolled back")
+}
@@ -0,0 +1,137 @@
+package promises
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// FromCond creates a promise that resolves when pred becomes true. The promise
+// goroutine acquires cond.L, checks pred and calls cond.Wait until pred returns
+// true, then releases cond.L. So pred is always called under the cond.L lock,
+// and the code that changes its result must hold the lock and call
+// cond.Broadcast (or cond.Signal) after the change.
+func FromCond(cond *sync.Cond, pred func() bool) Promise[struct{}] {
+	return NewVoid(func() error {
+		cond.L.Lock()
+		defer cond.L.Unlock()
+		for !pred() {
+			cond.Wait()
+		}
+		return nil
+	})
+}
+
+// FromWaitGroups creates a promise that resolves when all of the given wait
+// groups reach zero. Each wait group is waited for in its own goroutine. It
+// allows to join the shutdowns of several independent subsystems into one
+// promise, e.g. to race it against a deadline.
+func FromWaitGroups(wgs ...*sync.WaitGroup) Promise[struct{}] {
+	ps := make([]Promise[struct{}], len(wgs))
+	for i, wg := range wgs {
+		wg := wg
+		ps[i] = NewVoid(func() error {
+			wg.Wait()
+			return nil
+		})
+	}
+	return ThenMap(All(ps...), func([]struct{}) struct{} { return struct{}{} })
+}
+
+// FromAtomic creates a promise that polls v every poll interval and resolves
+// with the stored value once isReady returns true for it. The value is checked
+// immediately first; an empty v is never ready. It is a bridge for the code
+// that publishes its state via [atomic.Value] without any notification. If
+// poll is not positive, the promise rejects immediately.
+//
+// Polling has its cost: the goroutine wakes up every interval until the value
+// is ready, and it keeps polling even if nobody waits for the promise anymore.
+// Prefer [FromCond] or channels when possible, and use [WithContext] to bound
+// the wait on the consumer side.
+func FromAtomic[T any](v *atomic.Value, isReady func(T) bool, poll time.Duration) Promise[T] {
+	return pollUntil(poll, func() (T, bool, error) {
+		val, ok := v.Load().(T)
+		return val, ok && isReady(val), nil
+	})
+}
+
+// FromSyncMap creates a promise that polls m every poll interval and resolves
+// with the value stored under the key once it is present. If the value is not
+// a V, the promise rejects with [ErrTypeAssertion]. It is a bridge for the
+// caches based on [sync.Map]. Like [FromAtomic], it keeps polling until the key
+// appears, so use [WithContext] to bound the wait on the consumer side, and
+// rejects immediately if poll is not positive.
+func FromSyncMap[K comparable, V any](m *sync.Map, key K, poll time.Duration) Promise[V] {
+	return pollUntil(poll, func() (V, bool, error) {
+		raw, ok := m.Load(key)
+		if !ok {
+			return zero[V](), false, nil
+		}
+		val, ok := raw.(V)
+		if !ok {
+			return zero[V](), true, fmt.Errorf("%w: %T is not %T", ErrTypeAssertion, raw, val)
+		}
+		return val, true, nil
+	})
+}
+
+// pollUntil creates a promise that calls check immediately and then every
+// interval, until it reports done, and settles with its result. The interval
+// must be positive.
+func pollUntil[T any](interval time.Duration, check func() (T, bool, error)) Promise[T] {
+	if interval <= 0 {
+		return Reject[T](fmt.Errorf("invalid poll interval: %v", interval))
+	}
+	return New(func() (T, error) {
+		if val, done, err := check(); done {
+			return val, err
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			<-ticker.C
+			if val, done, err := check(); done {
+				return val, err
+			}
+		}
+	})
+}
+
+// Latch is a countdown latch: its Done promise resolves when the Count method is
+// called the target number of times. It is safe for concurrent use.
+type Latch struct {
+	mu      sync.Mutex
+	left    int
+	done    Promise[struct{}]
+	resolve func(struct{})
+}
+
+// NewLatch creates a new [Latch] with the given target. If the target is not
+// positive, the latch is already done.
+func NewLatch(target int) *Latch {
+	l := &Latch{left: target}
+	l.done, l.resolve, _ = WithResolvers[struct{}]()
+	if target <= 0 {
+		l.resolve(struct{}{})
+	}
+	return l
+}
+
+// Count counts down the latch. The calls beyond the target do nothing.
+func (l *Latch) Count() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.left <= 0 {
+		return
+	}
+	l.left--
+	if l.left == 0 {
+		l.resolve(struct{}{})
+	}
+}
+
+// Done returns a promise that resolves when the target count is reached.
+func (l *Latch) Done() Promise[struct{}] {
+	return l.done
+}
@@ -0,0 +1,176 @@
+package promises_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSyncSuite(t *testing.T) {
+	suite.Run(t, new(SyncSuite))
+}
+
+type SyncSuite struct {
+	suite.Suite
+}
+
+func (suite *SyncSuite) TestFromCond() {
+	mu := new(sync.Mutex)
+	cond := sync.NewCond(mu)
+	ready := false
+
+	promise := promises.FromCond(cond, func() bool { return ready })
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	go func() {
+		mu.Lock()
+		ready = true
+		mu.Unlock()
+		cond.Broadcast()
+	}()
+
+	_, err := promise.Wait()
+	suite.Nil(err)
+}
+
+func (suite *SyncSuite) TestFromCond_already_true() {
+	cond := sync.NewCond(new(sync.Mutex))
+	_, err := promises.FromCond(cond, func() bool { return true }).Wait()
+	suite.Nil(err)
+}
+
+func (suite *SyncSuite) TestLatch() {
+	const target = 10
+	latch := promises.NewLatch(target)
+
+	wg := new(sync.WaitGroup)
+	wg.Add(target - 1)
+	for i := 0; i < target-1; i++ {
+		go func() {
+			defer wg.Done()
+			latch.Count()
+		}()
+	}
+	wg.Wait()
+	suite.False(isSettled(latch.Done()), "latch should not be done")
+
+	latch.Count()
+	suite.True(isSettled(latch.Done()), "latch should be done")
+
+	latch.Count()
+	_, err := latch.Done().Wait()
+	suite.Nil(err)
+}
+
+func (suite *SyncSuite) TestLatch_zero() {
+	latch := promises.NewLatch(0)
+	suite.True(isSettled(latch.Done()), "latch should be done")
+}
+
+func (suite *SyncSuite) TestFromWaitGroups() {
+	var wg1, wg2 sync.WaitGroup
+	wg1.Add(1)
+	wg2.Add(1)
+
+	promise := promises.FromWaitGroups(&wg1, &wg2)
+	wg1.Done()
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	wg2.Done()
+	_, err := promise.Wait()
+	suite.Nil(err)
+}
+
+func (suite *SyncSuite) TestFromWaitGroups_empty() {
+	_, err := promises.FromWaitGroups().Wait()
+	suite.Nil(err)
+}
+
+func (suite *SyncSuite) TestFromAtomic() {
+	var v atomic.Value
+	v.Store(1)
+
+	promise := promises.FromAtomic(&v, func(n int) bool { return n >= 42 }, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		v.Store(42)
+	}()
+
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *SyncSuite) TestFromAtomic_empty() {
+	var v atomic.Value
+	promise := promises.FromAtomic(&v, func(string) bool { return true }, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "empty value should not be ready")
+
+	v.Store("ready")
+	val, err := promise.Wait()
+	suite.Equal("ready", val)
+	suite.Nil(err)
+}
+
+func (suite *SyncSuite) TestFromSyncMap() {
+	var m sync.Map
+	promise := promises.FromSyncMap[string, int](&m, "key", time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	suite.False(isSettled(promise), "promise should not be settled")
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		m.Store("other", 41)
+		m.Store("key", 42)
+	}()
+
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *SyncSuite) TestFromSyncMap_type_mismatch() {
+	var m sync.Map
+	m.Store("key", "42")
+	val, err := promises.FromSyncMap[string, int](&m, "key", time.Millisecond).Wait()
+	suite.Zero(val)
+	suite.ErrorIs(err, promises.ErrTypeAssertion)
+}
+
+func (suite *SyncSuite) TestFromAtomic_already_ready() {
+	var v atomic.Value
+	v.Store(42)
+	val, err := promises.FromAtomic(&v, func(int) bool { return true }, time.Hour).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *SyncSuite) TestFromAtomic_invalid_poll() {
+	var v atomic.Value
+	v.Store(42)
+	for _, poll := range []time.Duration{0, -time.Millisecond} {
+		val, err := promises.FromAtomic(&v, func(int) bool { return true }, poll).Wait()
+		suite.Zero(val)
+		suite.ErrorContains(err, "invalid poll interval")
+	}
+}
+
+func (suite *SyncSuite) TestFromSyncMap_invalid_poll() {
+	var m sync.Map
+	m.Store("key", 42)
+	for _, poll := range []time.Duration{0, -time.Millisecond} {
+		val, err := promises.FromSyncMap[string, int](&m, "key", poll).Wait()
+		suite.Zero(val)
+		suite.ErrorContains(err, "invalid poll interval")
+	}
+}
@@ -0,0 +1,35 @@
+package promises
+
+// Task is a type-erased promise that can be used in [AllTasks] together with
+// tasks of other types. Use [Adapt] to create a Task from a typed promise.
+type Task interface {
+	run() Result[any]
+}
+
+type adaptedTask[T any] struct {
+	p Promise[T]
+}
+
+func (t adaptedTask[T]) run() Result[any] {
+	v, err := t.p.Wait()
+	return Result[any]{v, err}
+}
+
+// Adapt wraps a typed promise into a [Task].
+func Adapt[T any](p Promise[T]) Task {
+	return adaptedTask[T]{p}
+}
+
+// AllTasks acts like [All], but takes the tasks of different types and fulfills
+// with an array of their fulfillment values as "any".
+func AllTasks(tasks ...Task) Promise[[]any] {
+	ps := make([]Promise[any], len(tasks))
+	for i, t := range tasks {
+		t := t
+		ps[i] = untracked(New(func() (any, error) {
+			r := t.run()
+			return r.Value, r.Err
+		}))
+	}
+	return All(ps...)
+}
@@ -0,0 +1,44 @@
+package promises_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTasksSuite(t *testing.T) {
+	suite.Run(t, new(TasksSuite))
+}
+
+type TasksSuite struct {
+	suite.Suite
+}
+
+func (suite *TasksSuite) TestAllTasks() {
+	p := promises.AllTasks(
+		promises.Adapt(promises.Resolve(42)),
+		promises.Adapt(promises.Resolve("foo")),
+	)
+	val, err := p.Wait()
+	suite.Equal([]any{42, "foo"}, val)
+	suite.Nil(err)
+}
+
+func (suite *TasksSuite) TestAllTasks_rejected() {
+	tgtErr := errors.New("test error")
+	p := promises.AllTasks(
+		promises.Adapt(promises.Resolve(42)),
+		promises.Adapt(promises.Reject[string](tgtErr)),
+	)
+	val, err := p.Wait()
+	suite.Nil(val)
+	suite.Equal(tgtErr, err)
+}
+
+func (suite *TasksSuite) TestAllTasks_empty() {
+	val, err := promises.AllTasks().Wait()
+	suite.Nil(val)
+	suite.Nil(err)
+}
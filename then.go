@@ -0,0 +1,253 @@
+package promises
+
+import (
+	"context"
+	"errors"
+	"runtime"
+)
+
+// Pipe builds a reusable pipeline from the given stages. The returned function
+// can be applied to any number of input promises; for each of them it waits for
+// the promise and, if it fulfilled, runs the stages sequentially, passing the
+// result of each stage to the next one. The first stage error rejects the
+// resulting promise, and the remaining stages are not called.
+func Pipe[T any](stages ...func(T) (T, error)) func(Promise[T]) Promise[T] {
+	return func(p Promise[T]) Promise[T] {
+		return Then(p, func(v T) (T, error) {
+			var err error
+			for _, stage := range stages {
+				v, err = stage(v)
+				if err != nil {
+					return zero[T](), err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+// TracedResult is the outcome of the [ThenTrace] chain.
+type TracedResult[T any] struct {
+	// Value is the final value of the chain.
+	Value T
+	// Err is the rejection reason of the source promise or the error of the
+	// failed step.
+	Err error
+	// Steps are the results of the successful steps, in order.
+	Steps []T
+}
+
+// ThenTrace acts like [Pipe] applied to p, but records the intermediate
+// values for debugging. The returned promise never rejects: it fulfills with
+// the [TracedResult] holding the final value or error, and the result of each
+// successful step. If a step fails (or panics, see [ErrPanic]), the rest of the
+// steps are not called, and Steps holds the values up to the failed step.
+func ThenTrace[T any](p Promise[T], fns ...func(T) (T, error)) Promise[TracedResult[T]] {
+	return New(func() (TracedResult[T], error) {
+		v, err := p.Wait()
+		if err != nil {
+			return TracedResult[T]{Err: err}, nil
+		}
+		steps := make([]T, 0, len(fns))
+		for _, fn := range fns {
+			cur := v
+			v, err = NewSync(func() (T, error) { return fn(cur) }).Wait()
+			if err != nil {
+				return TracedResult[T]{Err: err, Steps: steps}, nil
+			}
+			steps = append(steps, v)
+		}
+		return TracedResult[T]{Value: v, Steps: steps}, nil
+	})
+}
+
+// ThenMap acts like [Then], but takes a pure transform function that cannot
+// fail. If fn panics, the resulting promise is rejected with [ErrPanic].
+func ThenMap[T, P any](p Promise[T], fn func(T) P) Promise[P] {
+	return Then(p, func(v T) (P, error) { return fn(v), nil })
+}
+
+// ThenResult acts like [Then], but gen returns the [Result] that is unpacked to
+// settle the resulting promise. It is convenient for the callbacks that
+// already compute a Result (e.g. with [WaitResult]). If gen panics, the
+// resulting promise is rejected with [ErrPanic].
+func ThenResult[T, P any](p Promise[T], gen func(T) Result[P]) Promise[P] {
+	return Then(p, func(v T) (P, error) {
+		r := gen(v)
+		return r.Value, r.Err
+	})
+}
+
+// ThenAny waits for the first of the given promises to fulfill (see [Any]) and
+// calls gen with its fulfillment value. If all of the promises reject, the
+// resulting promise rejects with an [AggregateError], and gen is not called.
+func ThenAny[T, P any](ps []Promise[T], gen func(T) (P, error)) Promise[P] {
+	return Then(Any(ps...), gen)
+}
+
+// ThenIf acts like [Then], but calls gen only if cond returns true for the
+// fulfillment value; otherwise the value is passed through unchanged.
+// Rejections are passed through too, and neither cond nor gen is called.
+func ThenIf[T any](p Promise[T], cond func(T) bool, gen func(T) (T, error)) Promise[T] {
+	return Then(p, func(v T) (T, error) {
+		if !cond(v) {
+			return v, nil
+		}
+		return gen(v)
+	})
+}
+
+// ThenAll waits for all of the given promises (see [All]) and, if all of them
+// fulfilled, calls gen with the array of the fulfillment values. If any of the
+// promises rejects, the resulting promise rejects with this first rejection
+// reason, and gen is not called.
+func ThenAll[T, P any](ps []Promise[T], gen func([]T) (P, error)) Promise[P] {
+	return Then(All(ps...), gen)
+}
+
+// Fork waits for the given promise and, if it fulfilled, calls all of the fns
+// concurrently with the fulfillment value. The resulting promise fulfills with
+// the array of their results, in the order of fns, or rejects with the first fn
+// error (see [All]). If p rejects, the rejection is passed through, and none
+// of the fns is called.
+func Fork[T, R any](p Promise[T], fns ...func(T) (R, error)) Promise[[]R] {
+	return Then(p, func(v T) ([]R, error) {
+		ps := make([]Promise[R], len(fns))
+		for i, fn := range fns {
+			ps[i] = untracked(New1(fn, v))
+		}
+		return All(ps...).Wait()
+	})
+}
+
+// Recover waits for the given promise and, if it rejected with [ErrPanic],
+// calls the handler with the recovered panic value to produce the new result.
+// Other rejections and fulfillments are passed through untouched, so the
+// programmer-error panics can be handled separately from the regular errors.
+func Recover[T any](p Promise[T], handler func(panicValue any) (T, error)) Promise[T] {
+	return New(func() (T, error) {
+		v, err := p.Wait()
+		var panicErr *ErrPanic
+		if errors.As(err, &panicErr) {
+			return handler(panicErr.Value)
+		}
+		return v, err
+	})
+}
+
+// ThenSync acts like [Then], but requires the given promise to be already
+// settled and calls gen synchronously, avoiding the goroutine scheduling
+// overhead. The returned promise is already settled too. ThenSync panics if
+// the promise is still pending.
+func ThenSync[T, P any](p Promise[T], gen func(T) (P, error)) Promise[P] {
+	select {
+	case <-p.Done():
+	default:
+		panic("promises: ThenSync called with a pending promise")
+	}
+	return NewSync(func() (P, error) {
+		v, err := p.Wait()
+		if err != nil {
+			return zero[P](), err
+		}
+		return gen(v)
+	})
+}
+
+// ThenMapSlice waits for the given promise of a slice and, if it fulfilled,
+// calls fn for each element concurrently, with at most GOMAXPROCS calls running
+// at the same time. The returned promise fulfills with the slice of the mapped
+// values, or rejects with the first fn error. Use [ThenMapSliceLimit] to set
+// the concurrency limit explicitly.
+func ThenMapSlice[T, R any](p Promise[[]T], fn func(T) (R, error)) Promise[[]R] {
+	return ThenMapSliceLimit(p, runtime.GOMAXPROCS(0), fn)
+}
+
+// ThenMapSliceLimit acts like [ThenMapSlice], but with at most limit calls
+// running at the same time (no limit if limit is not positive). See
+// [MapGroup].
+func ThenMapSliceLimit[T, R any](p Promise[[]T], limit int, fn func(T) (R, error)) Promise[[]R] {
+	return Then(p, func(items []T) ([]R, error) {
+		return MapGroup(context.Background(), items, limit, func(_ context.Context, v T) (R, error) {
+			return fn(v)
+		}).Wait()
+	})
+}
+
+// Pipeline is a reusable chain of processing steps, that can be applied to
+// any number of input promises with [Pipeline.Run]. The zero value is an empty
+// pipeline that passes the input through. Pipeline is immutable: every
+// builder method returns a new pipeline, leaving the original one untouched.
+type Pipeline[T any] struct {
+	steps []pipelineStep[T]
+}
+
+type pipelineStep[T any] struct {
+	then    func(T) (T, error)
+	catch   func(error) (T, error)
+	finally func()
+}
+
+// Then returns a pipeline with the step that is called with the current value
+// if the pipeline is not failed. Its result replaces the current value or
+// fails the pipeline.
+func (pl Pipeline[T]) Then(fn func(T) (T, error)) Pipeline[T] {
+	return pl.with(pipelineStep[T]{then: fn})
+}
+
+// Catch returns a pipeline with the step that is called with the current
+// error if the pipeline is failed. Its result can recover the pipeline or
+// replace the error.
+func (pl Pipeline[T]) Catch(fn func(error) (T, error)) Pipeline[T] {
+	return pl.with(pipelineStep[T]{catch: fn})
+}
+
+// Finally returns a pipeline with the step that is always called and doesn't
+// change the current state, even if the pipeline is aborted. If the step
+// panics, the pipeline fails with the [ErrPanic], joined (see [errors.Join])
+// with the previous error if the pipeline has already failed.
+func (pl Pipeline[T]) Finally(fn func()) Pipeline[T] {
+	return pl.with(pipelineStep[T]{finally: fn})
+}
+
+func (pl Pipeline[T]) with(step pipelineStep[T]) Pipeline[T] {
+	steps := make([]pipelineStep[T], len(pl.steps), len(pl.steps)+1)
+	copy(steps, pl.steps)
+	return Pipeline[T]{append(steps, step)}
+}
+
+// Run applies the pipeline to the given promise. The steps are called
+// sequentially in a separate goroutine after the promise settles; the panics
+// in steps are converted to [ErrPanic] failures. If ctx is done, the returned
+// promise rejects with the context error immediately, and the remaining Then
+// and Catch steps are skipped (the Finally steps are still called). The
+// running step is not interrupted.
+//
+// The method value pl.Run can be used as a standalone runner function.
+func (pl Pipeline[T]) Run(ctx context.Context, p Promise[T]) Promise[T] {
+	steps := pl.steps
+	return WithContext(ctx, untracked(New(func() (T, error) {
+		v, err := WithContext(ctx, p).Wait()
+		for _, step := range steps {
+			if ctx.Err() != nil {
+				v, err = zero[T](), ctx.Err()
+				if step.finally == nil {
+					continue
+				}
+			}
+			cur, curErr := v, err
+			switch {
+			case step.then != nil && curErr == nil:
+				v, err = NewSync(func() (T, error) { return step.then(cur) }).Wait()
+			case step.catch != nil && curErr != nil:
+				v, err = NewSync(func() (T, error) { return step.catch(curErr) }).Wait()
+			case step.finally != nil:
+				_, fErr := NewSync(func() (T, error) { step.finally(); return cur, nil }).Wait()
+				if fErr != nil {
+					v, err = zero[T](), errors.Join(curErr, fErr)
+				}
+			}
+		}
+		return v, err
+	})))
+}
@@ -0,0 +1,501 @@
+package promises_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestThenSuite(t *testing.T) {
+	suite.Run(t, new(ThenSuite))
+}
+
+type ThenSuite struct {
+	suite.Suite
+}
+
+func (suite *ThenSuite) TestPipe() {
+	pipeline := promises.Pipe(
+		func(v int) (int, error) { return v + 1, nil },
+		func(v int) (int, error) { return v * 2, nil },
+	)
+
+	val, err := pipeline(promises.Resolve(1)).Wait()
+	suite.Equal(4, val)
+	suite.Nil(err)
+
+	val, err = pipeline(promises.Resolve(20)).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestPipe_stage_error() {
+	tgtErr := errors.New("test error")
+	called := false
+	pipeline := promises.Pipe(
+		func(v int) (int, error) { return 0, tgtErr },
+		func(v int) (int, error) { called = true; return v, nil },
+	)
+
+	val, err := pipeline(promises.Resolve(1)).Wait()
+	suite.Zero(val)
+	suite.Equal(tgtErr, err)
+	suite.False(called, "next stage should not be called")
+}
+
+func (suite *ThenSuite) TestPipe_rejected_input() {
+	tgtErr := errors.New("test error")
+	pipeline := promises.Pipe(func(v int) (int, error) { return v + 1, nil })
+
+	val, err := pipeline(promises.Reject[int](tgtErr)).Wait()
+	suite.Zero(val)
+	suite.Equal(tgtErr, err)
+}
+
+func (suite *ThenSuite) TestThenMap() {
+	promise := promises.ThenMap(promises.Resolve(42), func(v int) string {
+		return strconv.Itoa(v)
+	})
+	val, err := promise.Wait()
+	suite.Equal("42", val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestThenMap_rejected() {
+	tgtErr := errors.New("test error")
+	called := false
+	promise := promises.ThenMap(promises.Reject[int](tgtErr), func(v int) string {
+		called = true
+		return strconv.Itoa(v)
+	})
+	val, err := promise.Wait()
+	suite.Empty(val)
+	suite.Equal(tgtErr, err)
+	suite.False(called, "fn should not be called")
+}
+
+func (suite *ThenSuite) TestThenMap_panic() {
+	promise := promises.ThenMap(promises.Resolve(42), func(v int) string {
+		panic("AAA!")
+	})
+	_, err := promise.Wait()
+	var panicErr *promises.ErrPanic
+	suite.ErrorAs(err, &panicErr)
+	suite.Equal("AAA!", panicErr.Value)
+}
+
+func (suite *ThenSuite) TestThenResult_fulfilled() {
+	promise := promises.ThenResult(promises.Resolve(21), func(v int) promises.Result[string] {
+		return promises.Result[string]{Value: strconv.Itoa(v * 2)}
+	})
+	val, err := promise.Wait()
+	suite.Equal("42", val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestThenResult_rejected() {
+	tgtErr := errors.New("test error")
+	promise := promises.ThenResult(promises.Resolve(21), func(v int) promises.Result[string] {
+		return promises.Result[string]{Err: tgtErr}
+	})
+	val, err := promise.Wait()
+	suite.Empty(val)
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *ThenSuite) TestThenResult_source_rejected() {
+	tgtErr := errors.New("test error")
+	promise := promises.ThenResult(promises.Reject[int](tgtErr), func(v int) promises.Result[string] {
+		suite.Fail("gen should not be called")
+		return promises.Result[string]{}
+	})
+	_, err := promise.Wait()
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *ThenSuite) TestThenResult_panic() {
+	promise := promises.ThenResult(promises.Resolve(21), func(v int) promises.Result[string] {
+		panic("AAA!")
+	})
+	_, err := promise.Wait()
+	var panicErr *promises.ErrPanic
+	suite.ErrorAs(err, &panicErr)
+}
+
+func (suite *ThenSuite) TestThenIf_true() {
+	promise := promises.ThenIf(promises.Resolve(21),
+		func(v int) bool { return v < 42 },
+		func(v int) (int, error) { return v * 2, nil },
+	)
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestThenIf_false() {
+	promise := promises.ThenIf(promises.Resolve(50),
+		func(v int) bool { return v < 42 },
+		func(v int) (int, error) { suite.Fail("gen should not be called"); return 0, nil },
+	)
+	val, err := promise.Wait()
+	suite.Equal(50, val, "value should be passed through")
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestThenIf_rejected() {
+	tgtErr := errors.New("test error")
+	promise := promises.ThenIf(promises.Reject[int](tgtErr),
+		func(v int) bool { suite.Fail("cond should not be called"); return true },
+		func(v int) (int, error) { suite.Fail("gen should not be called"); return 0, nil },
+	)
+	_, err := promise.Wait()
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *ThenSuite) TestFork() {
+	val, err := promises.Fork(promises.Resolve(21),
+		func(v int) (string, error) { return strconv.Itoa(v), nil },
+		func(v int) (string, error) { return strconv.Itoa(v * 2), nil },
+		func(v int) (string, error) { return strconv.Itoa(-v), nil },
+	).Wait()
+	suite.Equal([]string{"21", "42", "-21"}, val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestFork_error() {
+	tgtErr := errors.New("test error")
+	val, err := promises.Fork(promises.Resolve(21),
+		func(v int) (string, error) { return strconv.Itoa(v), nil },
+		func(v int) (string, error) { return "", tgtErr },
+		func(v int) (string, error) { return strconv.Itoa(-v), nil },
+	).Wait()
+	suite.Nil(val)
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *ThenSuite) TestFork_rejected() {
+	tgtErr := errors.New("test error")
+	val, err := promises.Fork(promises.Reject[int](tgtErr),
+		func(v int) (string, error) { suite.Fail("fn should not be called"); return "", nil },
+	).Wait()
+	suite.Nil(val)
+	suite.ErrorIs(err, tgtErr)
+}
+
+func (suite *ThenSuite) TestThenTrace() {
+	val, err := promises.ThenTrace(promises.Resolve(1),
+		func(v int) (int, error) { return v + 1, nil },
+		func(v int) (int, error) { return v * 10, nil },
+		func(v int) (int, error) { return v + 22, nil },
+	).Wait()
+	suite.Nil(err)
+	suite.Equal(promises.TracedResult[int]{Value: 42, Steps: []int{2, 20, 42}}, val)
+}
+
+func (suite *ThenSuite) TestThenTrace_step_error() {
+	tgtErr := errors.New("test error")
+	val, err := promises.ThenTrace(promises.Resolve(1),
+		func(v int) (int, error) { return v + 1, nil },
+		func(v int) (int, error) { return 0, tgtErr },
+		func(v int) (int, error) { suite.Fail("step should not be called"); return v, nil },
+	).Wait()
+	suite.Nil(err, "traced promise should fulfill")
+	suite.Zero(val.Value)
+	suite.ErrorIs(val.Err, tgtErr)
+	suite.Equal([]int{2}, val.Steps)
+}
+
+func (suite *ThenSuite) TestThenTrace_source_rejected() {
+	tgtErr := errors.New("test error")
+	val, err := promises.ThenTrace(promises.Reject[int](tgtErr),
+		func(v int) (int, error) { suite.Fail("step should not be called"); return v, nil },
+	).Wait()
+	suite.Nil(err)
+	suite.ErrorIs(val.Err, tgtErr)
+	suite.Empty(val.Steps)
+}
+
+func (suite *ThenSuite) TestThenAny() {
+	slow, _, _ := promises.WithResolvers[int]()
+	promise := promises.ThenAny(
+		[]promises.Promise[int]{slow, promises.Reject[int](errors.New("test error")), promises.Resolve(21)},
+		func(v int) (string, error) { return strconv.Itoa(v * 2), nil },
+	)
+	val, err := promise.Wait()
+	suite.Equal("42", val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestThenAny_all_rejected() {
+	tgtErr1 := errors.New("test error 1")
+	tgtErr2 := errors.New("test error 2")
+	promise := promises.ThenAny(
+		[]promises.Promise[int]{promises.Reject[int](tgtErr1), promises.Reject[int](tgtErr2)},
+		func(v int) (string, error) { suite.Fail("gen should not be called"); return "", nil },
+	)
+	val, err := promise.Wait()
+	suite.Empty(val)
+	suite.Equal(&promises.AggregateError{Errors: []error{tgtErr1, tgtErr2}}, err)
+}
+
+func (suite *ThenSuite) TestThenAll() {
+	promise := promises.ThenAll(
+		[]promises.Promise[int]{promises.Resolve(41), promises.Resolve(42)},
+		func(vs []int) (int, error) { return vs[0] + vs[1], nil },
+	)
+	val, err := promise.Wait()
+	suite.Equal(83, val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestThenAll_rejected() {
+	tgtErr := errors.New("test error")
+	called := false
+	promise := promises.ThenAll(
+		[]promises.Promise[int]{promises.Resolve(41), promises.Reject[int](tgtErr)},
+		func(vs []int) (int, error) { called = true; return 0, nil },
+	)
+	val, err := promise.Wait()
+	suite.Zero(val)
+	suite.Equal(tgtErr, err)
+	suite.False(called, "gen should not be called")
+}
+
+func (suite *ThenSuite) TestRecover_panic() {
+	promise := promises.Recover(
+		promises.New(func() (int, error) { panic("AAA!") }),
+		func(v any) (int, error) {
+			suite.Equal("AAA!", v)
+			return 42, nil
+		},
+	)
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestRecover_error() {
+	tgtErr := errors.New("test error")
+	called := false
+	promise := promises.Recover(
+		promises.Reject[int](tgtErr),
+		func(any) (int, error) { called = true; return 42, nil },
+	)
+	val, err := promise.Wait()
+	suite.Zero(val)
+	suite.Equal(tgtErr, err)
+	suite.False(called, "handler should not be called")
+}
+
+func (suite *ThenSuite) TestRecover_resolved() {
+	promise := promises.Recover(
+		promises.Resolve(42),
+		func(any) (int, error) { return 0, nil },
+	)
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestThenSync() {
+	promise := promises.ThenSync(promises.Resolve(41), func(v int) (int, error) {
+		return v + 1, nil
+	})
+	suite.True(isSettled(promise), "promise should be settled")
+	val, err := promise.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestThenSync_rejected() {
+	tgtErr := errors.New("test error")
+	promise := promises.ThenSync(promises.Reject[int](tgtErr), func(v int) (int, error) {
+		return v + 1, nil
+	})
+	suite.True(isSettled(promise), "promise should be settled")
+	_, err := promise.Wait()
+	suite.Equal(tgtErr, err)
+}
+
+func (suite *ThenSuite) TestThenSync_pending() {
+	pending, _, _ := promises.WithResolvers[int]()
+	suite.Panics(func() {
+		promises.ThenSync(pending, func(v int) (int, error) { return v, nil })
+	})
+}
+
+func BenchmarkThen(b *testing.B) {
+	p := promises.Resolve(42)
+	gen := func(v int) (int, error) { return v + 1, nil }
+	for i := 0; i < b.N; i++ {
+		_, _ = promises.Then(p, gen).Wait()
+	}
+}
+
+func BenchmarkThenSync(b *testing.B) {
+	p := promises.Resolve(42)
+	gen := func(v int) (int, error) { return v + 1, nil }
+	for i := 0; i < b.N; i++ {
+		_, _ = promises.ThenSync(p, gen).Wait()
+	}
+}
+
+func (suite *ThenSuite) TestThenMapSlice() {
+	promise := promises.ThenMapSlice(
+		promises.All(promises.Resolve(1), promises.Resolve(2), promises.Resolve(3)),
+		func(v int) (string, error) { return strconv.Itoa(v * v), nil },
+	)
+	val, err := promise.Wait()
+	suite.Equal([]string{"1", "4", "9"}, val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestThenMapSlice_error() {
+	tgtErr := errors.New("test error")
+	promise := promises.ThenMapSliceLimit(
+		promises.Resolve([]int{1, 2, 3}),
+		1,
+		func(v int) (int, error) {
+			if v == 2 {
+				return 0, tgtErr
+			}
+			return v, nil
+		},
+	)
+	val, err := promise.Wait()
+	suite.Nil(val)
+	suite.Equal(tgtErr, err)
+}
+
+func (suite *ThenSuite) TestPipeline() {
+	tgtErr := errors.New("test error")
+	var finallyCalls atomic.Int32
+	pipeline := promises.Pipeline[int]{}.
+		Then(func(v int) (int, error) {
+			if v < 0 {
+				return 0, tgtErr
+			}
+			return v + 1, nil
+		}).
+		Then(func(v int) (int, error) { return v * 2, nil }).
+		Catch(func(err error) (int, error) {
+			if errors.Is(err, tgtErr) {
+				return -1, nil
+			}
+			return 0, err
+		}).
+		Finally(func() { finallyCalls.Add(1) })
+
+	val, err := pipeline.Run(context.Background(), promises.Resolve(20)).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+
+	val, err = pipeline.Run(context.Background(), promises.Resolve(-5)).Wait()
+	suite.Equal(-1, val, "error should be recovered by Catch")
+	suite.Nil(err)
+
+	suite.Equal(int32(2), finallyCalls.Load())
+}
+
+func (suite *ThenSuite) TestPipeline_cancel() {
+	var finallyCalls atomic.Int32
+	var lastCalls atomic.Int32
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	pipeline := promises.Pipeline[int]{}.
+		Then(func(v int) (int, error) {
+			started <- struct{}{}
+			<-release
+			return v + 1, nil
+		}).
+		Then(func(v int) (int, error) { lastCalls.Add(1); return v * 2, nil }).
+		Finally(func() { finallyCalls.Add(1) })
+	run := pipeline.Run
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := run(ctx, promises.Resolve(1))
+	completed := run(context.Background(), promises.Resolve(20))
+	<-started
+	<-started
+	cancel()
+
+	val, err := canceled.Wait()
+	suite.Zero(val)
+	suite.ErrorIs(err, context.Canceled)
+
+	close(release)
+	val, err = completed.Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+
+	suite.Eventually(func() bool { return finallyCalls.Load() == 2 }, time.Second, time.Millisecond)
+	suite.Equal(int32(1), lastCalls.Load(), "steps after cancellation should be skipped")
+}
+
+func (suite *ThenSuite) TestPipeline_panic() {
+	pipeline := promises.Pipeline[int]{}.
+		Then(func(v int) (int, error) { panic("boom") }).
+		Catch(func(err error) (int, error) {
+			var panicErr *promises.ErrPanic
+			if errors.As(err, &panicErr) {
+				return 42, nil
+			}
+			return 0, err
+		})
+	val, err := pipeline.Run(context.Background(), promises.Resolve(1)).Wait()
+	suite.Equal(42, val)
+	suite.Nil(err)
+}
+
+func (suite *ThenSuite) TestPipeline_finally_panic() {
+	pipeline := promises.Pipeline[int]{}.
+		Then(func(v int) (int, error) { return v + 1, nil }).
+		Finally(func() { panic("cleanup") })
+	val, err := pipeline.Run(context.Background(), promises.Resolve(1)).Wait()
+	suite.Zero(val)
+	var panicErr *promises.ErrPanic
+	suite.Require().ErrorAs(err, &panicErr)
+	suite.Equal("cleanup", panicErr.Value)
+}
+
+func (suite *ThenSuite) TestPipeline_finally_panic_after_failure() {
+	tgtErr := errors.New("test error")
+	pipeline := promises.Pipeline[int]{}.
+		Then(func(v int) (int, error) { return 0, tgtErr }).
+		Finally(func() { panic("cleanup") })
+	_, err := pipeline.Run(context.Background(), promises.Resolve(1)).Wait()
+	suite.ErrorIs(err, tgtErr, "step error should be kept")
+	var panicErr *promises.ErrPanic
+	suite.Require().ErrorAs(err, &panicErr, "cleanup panic should be reported")
+	suite.Equal("cleanup", panicErr.Value)
+}
+
+func (suite *ThenSuite) TestPipeline_finally_after_failure() {
+	tgtErr := errors.New("test error")
+	called := false
+	pipeline := promises.Pipeline[int]{}.
+		Then(func(v int) (int, error) { return 0, tgtErr }).
+		Finally(func() { called = true })
+	_, err := pipeline.Run(context.Background(), promises.Resolve(1)).Wait()
+	suite.Equal(tgtErr, err, "error should not be changed")
+	suite.True(called, "finally should be called")
+}
+
+func (suite *ThenSuite) TestPipeline_immutable() {
+	base := promises.Pipeline[int]{}.Then(func(v int) (int, error) { return v + 1, nil })
+	double := base.Then(func(v int) (int, error) { return v * 2, nil })
+	negate := base.Then(func(v int) (int, error) { return -v, nil })
+
+	val, _ := double.Run(context.Background(), promises.Resolve(1)).Wait()
+	suite.Equal(4, val)
+	val, _ = negate.Run(context.Background(), promises.Resolve(1)).Wait()
+	suite.Equal(-2, val)
+	val, _ = promises.Pipeline[int]{}.Run(context.Background(), promises.Resolve(1)).Wait()
+	suite.Equal(1, val, "empty pipeline should pass the value through")
+}
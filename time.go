@@ -0,0 +1,28 @@
+package promises
+
+import (
+	"fmt"
+	"time"
+)
+
+// AfterTicks creates a promise that resolves with the time of the n-th tick of
+// a ticker with the period d. The ticker is stopped when the promise settles.
+// The promise rejects immediately if n is less than 1 or d is not positive.
+func AfterTicks(d time.Duration, n int) Promise[time.Time] {
+	if d <= 0 {
+		return Reject[time.Time](fmt.Errorf("invalid tick period: %v", d))
+	}
+	if n < 1 {
+		return Reject[time.Time](fmt.Errorf("invalid number of ticks: %d", n))
+	}
+	return New(func() (time.Time, error) {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+
+		var t time.Time
+		for i := 0; i < n; i++ {
+			t = <-ticker.C
+		}
+		return t, nil
+	})
+}
@@ -0,0 +1,45 @@
+package promises_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/davidmz/go-promises"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTimeSuite(t *testing.T) {
+	suite.Run(t, new(TimeSuite))
+}
+
+type TimeSuite struct {
+	suite.Suite
+}
+
+func (suite *TimeSuite) TestAfterTicks_one() {
+	start := time.Now()
+	val, err := promises.AfterTicks(20*time.Millisecond, 1).Wait()
+	suite.Nil(err)
+	suite.GreaterOrEqual(val.Sub(start), 20*time.Millisecond)
+	suite.Less(val.Sub(start), 200*time.Millisecond)
+}
+
+func (suite *TimeSuite) TestAfterTicks_three() {
+	start := time.Now()
+	val, err := promises.AfterTicks(20*time.Millisecond, 3).Wait()
+	suite.Nil(err)
+	suite.GreaterOrEqual(val.Sub(start), 60*time.Millisecond)
+	suite.Less(val.Sub(start), 300*time.Millisecond)
+}
+
+func (suite *TimeSuite) TestAfterTicks_invalid() {
+	_, err := promises.AfterTicks(time.Millisecond, 0).Wait()
+	suite.Error(err)
+}
+
+func (suite *TimeSuite) TestAfterTicks_invalid_period() {
+	for _, d := range []time.Duration{0, -time.Millisecond} {
+		_, err := promises.AfterTicks(d, 1).Wait()
+		suite.ErrorContains(err, "invalid tick period")
+	}
+}